@@ -0,0 +1,245 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ resource.Resource                = (*accountWebhookResource)(nil)
+	_ resource.ResourceWithImportState = (*accountWebhookResource)(nil)
+	_ resource.ResourceWithConfigure   = (*accountWebhookResource)(nil)
+)
+
+// NewAccountWebhookResource is the constructor registered with the framework
+// provider for mailgun_account_webhook.
+func NewAccountWebhookResource() resource.Resource {
+	return &accountWebhookResource{}
+}
+
+// accountWebhookResource manages a webhook that fires for every domain in
+// the account rather than for a single domain.
+type accountWebhookResource struct {
+	cfg *mailgunpkg.Config
+}
+
+type accountWebhookResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Region      types.String `tfsdk:"region"`
+	URL         types.String `tfsdk:"url"`
+	EventTypes  types.Set    `tfsdk:"event_types"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *accountWebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_webhook"
+}
+
+func (r *accountWebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("us"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					webhookURL(),
+				},
+			},
+			"event_types": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Events that trigger the webhook. Kinds: " + webhookKindsDescription() + ".",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(allowedWebhookKinds...)),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (r *accountWebhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+// ImportState accepts "region:webhook_id" or a bare webhook id (region
+// defaults to "us").
+func (r *accountWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	region, id := "us", req.ID
+	if parts := strings.SplitN(req.ID, ":", 2); len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		region, id = parts[0], parts[1]
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+}
+
+func (r *accountWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan accountWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, d := accountWebhookFromModel(ctx, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := r.cfg.CreateAccountWebhook(ctx, plan.Region.ValueString(), hook)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create account webhook", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(id)
+	log.Printf("[INFO] Create account webhook ID: %s", id)
+
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *accountWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state accountWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, err := r.cfg.GetAccountWebhook(ctx, state.Region.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if mailgunpkg.IsNotFound(err) {
+			log.Printf("[WARN] Mailgun account webhook %s not found, removing from state", state.ID.ValueString())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read account webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(applyAccountWebhook(ctx, hook, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *accountWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan accountWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, d := accountWebhookFromModel(ctx, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.cfg.UpdateAccountWebhook(ctx, plan.Region.ValueString(), hook); err != nil {
+		resp.Diagnostics.AddError("Failed to update account webhook", err.Error())
+		return
+	}
+	log.Printf("[INFO] Update account webhook ID: %s", plan.ID.ValueString())
+
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *accountWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state accountWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.cfg.DeleteAccountWebhook(ctx, state.Region.ValueString(), state.ID.ValueString())
+	if err != nil && !mailgunpkg.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete account webhook", err.Error())
+		return
+	}
+	log.Printf("[INFO] Delete account webhook ID: %s", state.ID.ValueString())
+}
+
+// refresh re-reads the webhook after a write so state reflects what Mailgun
+// stored.
+func (r *accountWebhookResource) refresh(ctx context.Context, m *accountWebhookResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	hook, err := r.cfg.GetAccountWebhook(ctx, m.Region.ValueString(), m.ID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to refresh account webhook", err.Error())
+		return diags
+	}
+	return applyAccountWebhook(ctx, hook, m)
+}
+
+func accountWebhookFromModel(ctx context.Context, m *accountWebhookResourceModel) (mailgunpkg.AccountWebhook, diag.Diagnostics) {
+	hook := mailgunpkg.AccountWebhook{
+		ID:          m.ID.ValueString(),
+		URL:         m.URL.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+	d := m.EventTypes.ElementsAs(ctx, &hook.EventTypes, false)
+	return hook, d
+}
+
+func applyAccountWebhook(ctx context.Context, hook mailgunpkg.AccountWebhook, m *accountWebhookResourceModel) diag.Diagnostics {
+	eventTypes, d := types.SetValueFrom(ctx, types.StringType, hook.EventTypes)
+	if d.HasError() {
+		return d
+	}
+	m.ID = types.StringValue(hook.ID)
+	m.URL = types.StringValue(hook.URL)
+	m.EventTypes = eventTypes
+	m.Description = types.StringValue(hook.Description)
+	return nil
+}
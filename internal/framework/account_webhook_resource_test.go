@@ -0,0 +1,69 @@
+package framework_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+func TestAccMailgunAccountWebhook_Basic(t *testing.T) {
+	resourceName := "mailgun_account_webhook.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunAccountWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunAccountWebhookConfig(`["delivered", "opened"]`, "all domains"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "event_types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "all domains"),
+				),
+			},
+			{
+				Config: testAccMailgunAccountWebhookConfig(`["permanent_fail", "list_uploaded"]`, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(resourceName, "event_types.*", "list_uploaded"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMailgunAccountWebhookDestroy(s *terraform.State) error {
+	cfg := &mailgunpkg.Config{APIKey: os.Getenv("MAILGUN_API_KEY")}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mailgun_account_webhook" {
+			continue
+		}
+		hook, err := cfg.GetAccountWebhook(context.Background(), rs.Primary.Attributes["region"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Account webhook still exists: %#v", hook)
+		}
+	}
+	return nil
+}
+
+func testAccMailgunAccountWebhookConfig(eventTypes, description string) string {
+	return fmt.Sprintf(`
+resource "mailgun_account_webhook" "foobar" {
+    url         = "https://example.com/account-hook"
+    event_types = %s
+    description = %q
+}
+`, eventTypes, description)
+}
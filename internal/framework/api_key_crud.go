@@ -2,12 +2,17 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mailgun/mailgun-go/v5"
 	"github.com/mailgun/mailgun-go/v5/mtypes"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
 )
 
 func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -39,11 +44,14 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	r.cfg.InvalidateAPIKeys(plan.Region.ValueString())
+
 	plan.ID = types.StringValue(apiKey.ID)
 	plan.Requestor = types.StringValue(apiKey.Requestor)
 	plan.Secret = types.StringValue(apiKey.Secret)
 	plan.IsDisabled = types.BoolValue(apiKey.IsDisabled)
 	plan.DisabledReason = types.StringValue(apiKey.DisabledReason)
+	plan.IsExpired = types.BoolValue(apiKeyExpired(apiKey, time.Now()))
 	log.Printf("[INFO] API key ID: %s", plan.ID.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -56,13 +64,7 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	client, err := r.cfg.GetClient(state.Region.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Mailgun client error", err.Error())
-		return
-	}
-
-	apiKey, found, err := lookupAPIKey(ctx, client, state.ID.ValueString())
+	apiKey, found, err := lookupAPIKey(ctx, r.cfg, &state)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to retrieve API key list", err.Error())
 		return
@@ -74,15 +76,31 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	applyAPIKeyToModel(&state, apiKey)
+	// Keys created before recreate_when_disabled existed have it null in
+	// state; fill in the default so they do not plan an update.
+	if state.RecreateWhenDisabled.IsNull() {
+		state.RecreateWhenDisabled = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(apiKeyHealthDiagnostics(&state)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Update is unreachable: every writable attribute uses RequiresReplace, so
-// the framework always plans a destroy/create instead of an in-place update.
-// The method exists only to satisfy resource.Resource.
-func (r *apiKeyResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update unsupported",
-		"mailgun_api_key has no updatable attributes; this should not be reachable")
+// Update only handles recreate_when_disabled, which is provider-side
+// behaviour with no Mailgun counterpart. Every other writable attribute uses
+// RequiresReplace, so no API call is needed here; computed values are carried
+// over from the prior state.
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.IsDisabled = state.IsDisabled
+	plan.DisabledReason = state.DisabledReason
+	plan.IsExpired = state.IsExpired
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -103,15 +121,20 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		resp.Diagnostics.AddError("Failed to delete API key", err.Error())
 		return
 	}
+	r.cfg.InvalidateAPIKeys(state.Region.ValueString())
 }
 
-// lookupAPIKey returns the API key with the given ID and a found flag. It
-// pages through ListAPIKeys because the Mailgun API has no GET-by-id endpoint.
-func lookupAPIKey(ctx context.Context, client *mailgun.Client, id string) (mtypes.APIKey, bool, error) {
-	keys, err := client.ListAPIKeys(ctx, nil)
+// lookupAPIKey returns the API key tracked by m and a found flag. It scans
+// ListAPIKeys because the Mailgun API has no GET-by-id endpoint; the
+// unfiltered listing of the region is served from the provider-wide cache,
+// so refreshing many keys, whatever their kind and domain, costs a single
+// list call.
+func lookupAPIKey(ctx context.Context, cfg *mailgunpkg.Config, m *apiKeyResourceModel) (mtypes.APIKey, bool, error) {
+	keys, err := cfg.ListAPIKeys(ctx, m.Region.ValueString(), nil)
 	if err != nil {
 		return mtypes.APIKey{}, false, err
 	}
+	id := m.ID.ValueString()
 	for _, k := range keys {
 		if k.ID == id {
 			return k, true, nil
@@ -135,11 +158,39 @@ func applyAPIKeyToModel(m *apiKeyResourceModel, k mtypes.APIKey) {
 	m.Requestor = types.StringValue(k.Requestor)
 	m.IsDisabled = types.BoolValue(k.IsDisabled)
 	m.DisabledReason = types.StringValue(k.DisabledReason)
+	m.IsExpired = types.BoolValue(apiKeyExpired(k, time.Now()))
 	if k.Secret != "" {
 		m.Secret = types.StringValue(k.Secret)
 	}
 }
 
+// apiKeyExpired reports whether the key has an expiry that lies before now.
+// Keys without an expiry come back with a zero expires_at.
+func apiKeyExpired(k mtypes.APIKey, now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && k.ExpiresAt.Before(now)
+}
+
+// apiKeyHealthDiagnostics warns about keys that still exist in Mailgun but can
+// no longer authenticate. Mailgun keeps such keys listed, so without the
+// warning they would sit in state looking healthy.
+func apiKeyHealthDiagnostics(m *apiKeyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	hint := "Set recreate_when_disabled = true to have Terraform replace it on the next apply."
+	if m.RecreateWhenDisabled.ValueBool() {
+		hint = "Terraform will replace it on the next apply because recreate_when_disabled is set."
+	}
+	if m.IsDisabled.ValueBool() {
+		diags.AddWarning("API key is disabled",
+			fmt.Sprintf("Mailgun reports API key %s as disabled (reason: %q). %s",
+				m.ID.ValueString(), m.DisabledReason.ValueString(), hint))
+	}
+	if m.IsExpired.ValueBool() {
+		diags.AddWarning("API key has expired",
+			fmt.Sprintf("Mailgun API key %s is past its expiry. %s", m.ID.ValueString(), hint))
+	}
+	return diags
+}
+
 // stringOrNull returns types.StringNull for "" and types.StringValue otherwise.
 // Used to bridge the SDKv2 (empty == null) vs framework (distinct) gap when
 // reading API responses where a missing optional field comes back as "".
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -16,8 +18,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = (*apiKeyResource)(nil)
-	_ resource.ResourceWithConfigure = (*apiKeyResource)(nil)
+	_ resource.Resource               = (*apiKeyResource)(nil)
+	_ resource.ResourceWithConfigure  = (*apiKeyResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*apiKeyResource)(nil)
 )
 
 // NewAPIKeyResource is the constructor registered with the framework provider.
@@ -44,6 +47,9 @@ type apiKeyResourceModel struct {
 	Secret         types.String `tfsdk:"secret"`
 	IsDisabled     types.Bool   `tfsdk:"is_disabled"`
 	DisabledReason types.String `tfsdk:"disabled_reason"`
+	IsExpired      types.Bool   `tfsdk:"is_expired"`
+
+	RecreateWhenDisabled types.Bool `tfsdk:"recreate_when_disabled"`
 }
 
 func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -121,6 +127,14 @@ func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"disabled_reason": schema.StringAttribute{
 				Computed: true,
 			},
+			"is_expired": schema.BoolAttribute{
+				Computed: true,
+			},
+			"recreate_when_disabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -137,3 +151,31 @@ func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequ
 	}
 	r.cfg = cfg
 }
+
+// ModifyPlan forces replacement of a key that Mailgun reports as disabled or
+// expired when recreate_when_disabled is set, so the next apply restores a
+// working key instead of leaving a dead one in state.
+func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RecreateWhenDisabled.ValueBool() {
+		return
+	}
+	if !state.IsDisabled.ValueBool() && !state.IsExpired.ValueBool() {
+		return
+	}
+
+	plan.IsDisabled = types.BoolUnknown()
+	plan.IsExpired = types.BoolUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("is_disabled"), path.Root("is_expired"))
+}
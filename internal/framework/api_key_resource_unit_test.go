@@ -1,9 +1,15 @@
 package framework
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/mailgun/mailgun-go/v5/mtypes"
 )
 
@@ -67,3 +73,88 @@ func TestApplyAPIKeyToModel_SyncsDisabledFlags(t *testing.T) {
 		t.Errorf("disabled_reason = %q, want \"rotated\"", got)
 	}
 }
+
+func TestAPIKeyExpired(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	if apiKeyExpired(mtypes.APIKey{ID: "k1"}, now) {
+		t.Error("key without expires_at must not be treated as expired")
+	}
+	past := mtypes.APIKey{ExpiresAt: mtypes.ISO8601Time{Time: now.Add(-time.Minute)}}
+	if !apiKeyExpired(past, now) {
+		t.Error("key with expires_at in the past should be expired")
+	}
+	future := mtypes.APIKey{ExpiresAt: mtypes.ISO8601Time{Time: now.Add(time.Hour)}}
+	if apiKeyExpired(future, now) {
+		t.Error("key with expires_at in the future should not be expired")
+	}
+}
+
+func TestAPIKeyHealthDiagnostics(t *testing.T) {
+	healthy := &apiKeyResourceModel{
+		ID:         types.StringValue("k1"),
+		IsDisabled: types.BoolValue(false),
+		IsExpired:  types.BoolValue(false),
+	}
+	if d := apiKeyHealthDiagnostics(healthy); len(d) != 0 {
+		t.Errorf("healthy key produced diagnostics: %v", d)
+	}
+
+	broken := &apiKeyResourceModel{
+		ID:             types.StringValue("k1"),
+		IsDisabled:     types.BoolValue(true),
+		DisabledReason: types.StringValue("rotated"),
+		IsExpired:      types.BoolValue(true),
+	}
+	d := apiKeyHealthDiagnostics(broken)
+	if d.HasError() {
+		t.Fatalf("disabled/expired keys must only warn, got errors: %v", d)
+	}
+	if got := d.WarningsCount(); got != 2 {
+		t.Errorf("expected 2 warnings, got %d", got)
+	}
+}
+
+func TestAPIKeyModifyPlan_RecreateWhenDisabled(t *testing.T) {
+	ctx := context.Background()
+	r := &apiKeyResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	cases := []struct {
+		name     string
+		recreate types.Bool
+		disabled bool
+		want     bool
+	}{
+		{"disabled, recreate set", types.BoolValue(true), true, true},
+		{"disabled, recreate false", types.BoolValue(false), true, false},
+		{"disabled, recreate null", types.BoolNull(), true, false},
+		{"enabled, recreate set", types.BoolValue(true), false, false},
+	}
+	for _, tc := range cases {
+		state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}
+		state.SetAttribute(ctx, path.Root("id"), "k1")
+		state.SetAttribute(ctx, path.Root("is_disabled"), tc.disabled)
+		state.SetAttribute(ctx, path.Root("is_expired"), false)
+		if d := state.SetAttribute(ctx, path.Root("recreate_when_disabled"), tc.recreate); d.HasError() {
+			t.Fatal(d)
+		}
+		plan := tfsdk.Plan{Schema: sch, Raw: state.Raw.Copy()}
+
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: %v", tc.name, resp.Diagnostics)
+		}
+		if got := len(resp.RequiresReplace) > 0; got != tc.want {
+			t.Errorf("%s: requires replace = %t, want %t", tc.name, got, tc.want)
+		}
+		var planned apiKeyResourceModel
+		resp.Plan.Get(ctx, &planned)
+		if tc.want && !planned.IsDisabled.IsUnknown() {
+			t.Errorf("%s: is_disabled should be unknown after replacement, got %s", tc.name, planned.IsDisabled)
+		}
+	}
+}
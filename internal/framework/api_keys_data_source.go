@@ -0,0 +1,201 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mailgun/mailgun-go/v5"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ datasource.DataSource              = (*apiKeysDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*apiKeysDataSource)(nil)
+)
+
+// NewAPIKeysDataSource is the constructor registered with the framework
+// provider for data "mailgun_api_keys".
+func NewAPIKeysDataSource() datasource.DataSource {
+	return &apiKeysDataSource{}
+}
+
+type apiKeysDataSource struct {
+	cfg *mailgunpkg.Config
+}
+
+type apiKeysDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Region     types.String `tfsdk:"region"`
+	Role       types.String `tfsdk:"role"`
+	Kind       types.String `tfsdk:"kind"`
+	DomainName types.String `tfsdk:"domain_name"`
+	Disabled   types.Bool   `tfsdk:"disabled"`
+	Keys       types.List   `tfsdk:"keys"`
+}
+
+// apiKeySummaryModel mirrors a keys element. The secret is deliberately
+// absent: the data source is meant for auditing, not for handing keys out.
+type apiKeySummaryModel struct {
+	ID             types.String `tfsdk:"id"`
+	Description    types.String `tfsdk:"description"`
+	Kind           types.String `tfsdk:"kind"`
+	Role           types.String `tfsdk:"role"`
+	DomainName     types.String `tfsdk:"domain_name"`
+	Requestor      types.String `tfsdk:"requestor"`
+	UserName       types.String `tfsdk:"user_name"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	IsDisabled     types.Bool   `tfsdk:"is_disabled"`
+	DisabledReason types.String `tfsdk:"disabled_reason"`
+}
+
+func apiKeySummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":              types.StringType,
+		"description":     types.StringType,
+		"kind":            types.StringType,
+		"role":            types.StringType,
+		"domain_name":     types.StringType,
+		"requestor":       types.StringType,
+		"user_name":       types.StringType,
+		"expires_at":      types.StringType,
+		"is_disabled":     types.BoolType,
+		"disabled_reason": types.StringType,
+	}
+}
+
+func (d *apiKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_keys"
+}
+
+func (d *apiKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Attributes: map[string]dsschema.Attribute{
+			"id":          dsschema.StringAttribute{Computed: true},
+			"region":      dsschema.StringAttribute{Optional: true, Computed: true},
+			"role":        dsschema.StringAttribute{Optional: true},
+			"kind":        dsschema.StringAttribute{Optional: true},
+			"domain_name": dsschema.StringAttribute{Optional: true},
+			"disabled":    dsschema.BoolAttribute{Optional: true},
+			"keys": dsschema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id":              dsschema.StringAttribute{Computed: true},
+						"description":     dsschema.StringAttribute{Computed: true},
+						"kind":            dsschema.StringAttribute{Computed: true},
+						"role":            dsschema.StringAttribute{Computed: true},
+						"domain_name":     dsschema.StringAttribute{Computed: true},
+						"requestor":       dsschema.StringAttribute{Computed: true},
+						"user_name":       dsschema.StringAttribute{Computed: true},
+						"expires_at":      dsschema.StringAttribute{Computed: true},
+						"is_disabled":     dsschema.BoolAttribute{Computed: true},
+						"disabled_reason": dsschema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *apiKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	d.cfg = cfg
+}
+
+func (d *apiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data apiKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = "us"
+	}
+
+	// kind and domain_name are filtered by Mailgun; role and disabled are not
+	// supported by the list endpoint and are applied locally.
+	keys, err := d.cfg.ListAPIKeys(ctx, region, &mailgun.ListAPIKeysOptions{
+		Kind:       data.Kind.ValueString(),
+		DomainName: data.DomainName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve API key list", err.Error())
+		return
+	}
+
+	var disabled *bool
+	if !data.Disabled.IsNull() {
+		v := data.Disabled.ValueBool()
+		disabled = &v
+	}
+	keys = filterAPIKeys(keys, data.Role.ValueString(), disabled)
+
+	summaries := make([]apiKeySummaryModel, len(keys))
+	for i, k := range keys {
+		summaries[i] = apiKeySummaryFromAPI(k)
+	}
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: apiKeySummaryAttrTypes()}, summaries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(region)
+	data.Region = types.StringValue(region)
+	data.Keys = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterAPIKeys keeps the keys matching role (when non-empty) and disabled
+// (when non-nil), preserving the API order.
+func filterAPIKeys(keys []mtypes.APIKey, role string, disabled *bool) []mtypes.APIKey {
+	out := make([]mtypes.APIKey, 0, len(keys))
+	for _, k := range keys {
+		if role != "" && k.Role != role {
+			continue
+		}
+		if disabled != nil && k.IsDisabled != *disabled {
+			continue
+		}
+		out = append(out, k)
+	}
+	return out
+}
+
+// apiKeySummaryFromAPI maps a listed key to a keys element. expires_at is
+// rendered as RFC 3339 and left null for keys that never expire.
+func apiKeySummaryFromAPI(k mtypes.APIKey) apiKeySummaryModel {
+	expiresAt := types.StringNull()
+	if !k.ExpiresAt.IsZero() {
+		expiresAt = types.StringValue(k.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	return apiKeySummaryModel{
+		ID:             types.StringValue(k.ID),
+		Description:    stringOrNull(k.Description),
+		Kind:           types.StringValue(k.Kind),
+		Role:           types.StringValue(k.Role),
+		DomainName:     stringOrNull(k.DomainName),
+		Requestor:      stringOrNull(k.Requestor),
+		UserName:       stringOrNull(k.UserName),
+		ExpiresAt:      expiresAt,
+		IsDisabled:     types.BoolValue(k.IsDisabled),
+		DisabledReason: stringOrNull(k.DisabledReason),
+	}
+}
@@ -0,0 +1,45 @@
+package framework_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMailgunApiKeysDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunApiKeysDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mailgun_api_keys.test", "region", "us"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.mailgun_api_keys.test", "keys.*", map[string]string{
+						"description": "Test API keys data source",
+						"role":        "basic",
+						"kind":        "user",
+					}),
+					resource.TestCheckNoResourceAttr("data.mailgun_api_keys.test", "keys.0.secret"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMailgunApiKeysDataSourceConfig = `
+resource "mailgun_api_key" "foobar" {
+	description = "Test API keys data source"
+	role        = "basic"
+	kind        = "user"
+}
+
+data "mailgun_api_keys" "test" {
+	role     = "basic"
+	kind     = "user"
+	disabled = false
+
+	depends_on = [mailgun_api_key.foobar]
+}
+`
@@ -0,0 +1,55 @@
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+)
+
+func TestFilterAPIKeys(t *testing.T) {
+	keys := []mtypes.APIKey{
+		{ID: "a", Role: "admin"},
+		{ID: "b", Role: "sending", IsDisabled: true},
+		{ID: "c", Role: "sending"},
+	}
+
+	ids := func(ks []mtypes.APIKey) string {
+		s := ""
+		for _, k := range ks {
+			s += k.ID
+		}
+		return s
+	}
+
+	if got := ids(filterAPIKeys(keys, "", nil)); got != "abc" {
+		t.Errorf("no filters: got %q", got)
+	}
+	if got := ids(filterAPIKeys(keys, "sending", nil)); got != "bc" {
+		t.Errorf("role filter: got %q", got)
+	}
+	disabled := true
+	if got := ids(filterAPIKeys(keys, "", &disabled)); got != "b" {
+		t.Errorf("disabled=true filter: got %q", got)
+	}
+	enabled := false
+	if got := ids(filterAPIKeys(keys, "sending", &enabled)); got != "c" {
+		t.Errorf("role + disabled=false filter: got %q", got)
+	}
+}
+
+func TestAPIKeySummaryFromAPI(t *testing.T) {
+	s := apiKeySummaryFromAPI(mtypes.APIKey{ID: "a", Kind: "user", Role: "admin", Secret: "shh"})
+	if !s.ExpiresAt.IsNull() {
+		t.Errorf("expires_at should be null for keys without expiry, got %s", s.ExpiresAt)
+	}
+	if !s.DomainName.IsNull() {
+		t.Errorf("empty domain_name should map to null, got %s", s.DomainName)
+	}
+
+	exp := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	s = apiKeySummaryFromAPI(mtypes.APIKey{ID: "a", ExpiresAt: mtypes.ISO8601Time{Time: exp}})
+	if got := s.ExpiresAt.ValueString(); got != "2026-05-01T12:00:00Z" {
+		t.Errorf("expires_at = %q", got)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,8 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/mailgun/mailgun-go/v5"
-	"github.com/mailgun/mailgun-go/v5/mtypes"
 
 	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
 )
@@ -129,6 +126,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("Failed to create credential", err.Error())
 		return
 	}
+	r.cfg.InvalidateCredentials(plan.Region.ValueString(), domain)
 
 	plan.ID = types.StringValue(email)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -151,13 +149,7 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		}
 	}
 
-	client, err := r.cfg.GetClient(state.Region.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Mailgun client error", err.Error())
-		return
-	}
-
-	found, err := credentialExists(ctx, client, domain, state.ID.ValueString())
+	found, err := credentialExists(ctx, r.cfg, state.Region.ValueString(), domain, state.ID.ValueString())
 	if err != nil {
 		if mailgunpkg.IsNotFound(err) {
 			log.Printf("[WARN] Mailgun credential %s not found, removing from state", state.ID.ValueString())
@@ -219,24 +211,20 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 		resp.Diagnostics.AddError("Failed to delete credential", err.Error())
 		return
 	}
+	r.cfg.InvalidateCredentials(state.Region.ValueString(), state.Domain.ValueString())
 }
 
 // credentialExists reports whether a credential with the given email lives on
-// the domain. Pages through ListCredentials with a 30s timeout to match the
-// legacy behaviour.
-func credentialExists(ctx context.Context, client *mailgun.Client, domain, email string) (bool, error) {
-	it := client.ListCredentials(domain, nil)
-	pageCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
-	var page []mtypes.Credential
-	for it.Next(pageCtx, &page) {
-		for _, c := range page {
-			if c.Login == email {
-				return true, nil
-			}
-		}
+// the domain. It consults the provider-wide per-domain credential index, so
+// the domain's ListCredentials pages are fetched once per run rather than
+// once per credential.
+func credentialExists(ctx context.Context, cfg *mailgunpkg.Config, region, domain, email string) (bool, error) {
+	creds, err := cfg.Credentials(ctx, region, domain)
+	if err != nil {
+		return false, err
 	}
-	return false, it.Err()
+	_, ok := creds[email]
+	return ok, nil
 }
 
 // splitRegion returns the leading "region" of an "region:rest" id, or "" if
@@ -0,0 +1,168 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ datasource.DataSource              = (*domainCredentialsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*domainCredentialsDataSource)(nil)
+)
+
+// NewDomainCredentialsDataSource is the constructor registered with the
+// framework provider for data "mailgun_domain_credentials".
+func NewDomainCredentialsDataSource() datasource.DataSource {
+	return &domainCredentialsDataSource{}
+}
+
+type domainCredentialsDataSource struct {
+	cfg *mailgunpkg.Config
+}
+
+type domainCredentialsDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Region      types.String `tfsdk:"region"`
+	Domain      types.String `tfsdk:"domain"`
+	LoginPrefix types.String `tfsdk:"login_prefix"`
+	Credentials types.List   `tfsdk:"credentials"`
+}
+
+// credentialSummaryModel mirrors a credentials element. Passwords are never
+// returned by Mailgun and therefore not exposed.
+type credentialSummaryModel struct {
+	Login     types.String `tfsdk:"login"`
+	Email     types.String `tfsdk:"email"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	SizeBytes types.Int64  `tfsdk:"size_bytes"`
+}
+
+func credentialSummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"login":      types.StringType,
+		"email":      types.StringType,
+		"created_at": types.StringType,
+		"size_bytes": types.Int64Type,
+	}
+}
+
+func (d *domainCredentialsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_credentials"
+}
+
+func (d *domainCredentialsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Attributes: map[string]dsschema.Attribute{
+			"id":           dsschema.StringAttribute{Computed: true},
+			"region":       dsschema.StringAttribute{Optional: true, Computed: true},
+			"domain":       dsschema.StringAttribute{Required: true},
+			"login_prefix": dsschema.StringAttribute{Optional: true},
+			"credentials": dsschema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"login":      dsschema.StringAttribute{Computed: true},
+						"email":      dsschema.StringAttribute{Computed: true},
+						"created_at": dsschema.StringAttribute{Computed: true},
+						"size_bytes": dsschema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *domainCredentialsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	d.cfg = cfg
+}
+
+func (d *domainCredentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data domainCredentialsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = "us"
+	}
+	domain := data.Domain.ValueString()
+
+	// Shares the per-domain credential index with mailgun_domain_credential,
+	// so the listing is paged through at most once per run.
+	creds, err := d.cfg.Credentials(ctx, region, domain)
+	if err != nil {
+		if mailgunpkg.IsNotFound(err) {
+			resp.Diagnostics.AddError("Domain not found",
+				fmt.Sprintf("Mailgun domain %q does not exist", domain))
+			return
+		}
+		resp.Diagnostics.AddError("Failed to list credentials", err.Error())
+		return
+	}
+
+	summaries := credentialSummaries(creds, data.LoginPrefix.ValueString())
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: credentialSummaryAttrTypes()}, summaries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", region, domain))
+	data.Region = types.StringValue(region)
+	data.Credentials = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// credentialSummaries converts the credential index into elements sorted by
+// email, keeping those whose local part starts with prefix. login carries the
+// local part, matching the login argument of mailgun_domain_credential.
+func credentialSummaries(creds map[string]mailgunpkg.Credential, prefix string) []credentialSummaryModel {
+	emails := make([]string, 0, len(creds))
+	for email := range creds {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	out := []credentialSummaryModel{}
+	for _, email := range emails {
+		login := email
+		if at := lastIndexAt(email); at != -1 {
+			login = email[:at]
+		}
+		if !strings.HasPrefix(login, prefix) {
+			continue
+		}
+		createdAt := types.StringNull()
+		if c := creds[email].CreatedAt; !c.IsZero() {
+			createdAt = types.StringValue(time.Time(c).UTC().Format(time.RFC3339))
+		}
+		out = append(out, credentialSummaryModel{
+			Login:     types.StringValue(login),
+			Email:     types.StringValue(email),
+			CreatedAt: createdAt,
+			SizeBytes: types.Int64PointerValue(creds[email].SizeBytes),
+		})
+	}
+	return out
+}
@@ -0,0 +1,36 @@
+package framework_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMailgunDomainCredentialsDataSource_Basic(t *testing.T) {
+	uuid, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraformcreds.%s.com", uuid)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckMailgunCredentialConfig(domain) + `
+
+data "mailgun_domain_credentials" "test" {
+	domain       = mailgun_domain_credential.foobar.domain
+	login_prefix = "test_"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mailgun_domain_credentials.test", "credentials.#", "1"),
+					resource.TestCheckResourceAttr("data.mailgun_domain_credentials.test", "credentials.0.login", "test_crendential"),
+					resource.TestCheckResourceAttr("data.mailgun_domain_credentials.test", "credentials.0.email", "test_crendential@"+domain),
+					resource.TestCheckResourceAttrSet("data.mailgun_domain_credentials.test", "credentials.0.created_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+func TestCredentialSummaries(t *testing.T) {
+	created := mtypes.RFC2822Time(time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC))
+	size := int64(2048)
+	creds := map[string]mailgunpkg.Credential{
+		"svc-b@example.com": {Login: "svc-b@example.com"},
+		"alice@example.com": {Login: "alice@example.com", CreatedAt: created, SizeBytes: &size},
+		"svc-a@example.com": {Login: "svc-a@example.com"},
+	}
+
+	all := credentialSummaries(creds, "")
+	if len(all) != 3 {
+		t.Fatalf("expected 3 credentials, got %d", len(all))
+	}
+	if got := all[0].Email.ValueString(); got != "alice@example.com" {
+		t.Errorf("credentials should be sorted by email, first is %q", got)
+	}
+	if got := all[0].Login.ValueString(); got != "alice" {
+		t.Errorf("login should be the local part, got %q", got)
+	}
+	if got := all[0].CreatedAt.ValueString(); got != "2025-03-04T05:06:07Z" {
+		t.Errorf("created_at = %q", got)
+	}
+	if got := all[0].SizeBytes.ValueInt64(); got != 2048 {
+		t.Errorf("size_bytes = %d", got)
+	}
+	if !all[1].CreatedAt.IsNull() {
+		t.Errorf("missing created_at should be null, got %s", all[1].CreatedAt)
+	}
+	if !all[1].SizeBytes.IsNull() {
+		t.Errorf("missing size_bytes should be null, got %s", all[1].SizeBytes)
+	}
+
+	svc := credentialSummaries(creds, "svc-")
+	if len(svc) != 2 || svc[0].Login.ValueString() != "svc-a" || svc[1].Login.ValueString() != "svc-b" {
+		t.Errorf("prefix filter returned %#v", svc)
+	}
+}
@@ -6,14 +6,17 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mailgun/mailgun-go/v5"
 	"github.com/mailgun/mailgun-go/v5/mtypes"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
 )
 
 func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan domainResourceModel
+	var plan protectedDomainModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -63,8 +66,11 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	plan.ID = types.StringValue(name)
+	if plan.ForceDkimAuthority.IsUnknown() {
+		plan.ForceDkimAuthority = types.BoolValue(false)
+	}
 	planPwd := plan.SmtpPassword
-	diags, _ := refreshDomain(ctx, client, name, &plan)
+	diags, _ := refreshDomain(ctx, client, name, &plan.domainResourceModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -86,7 +92,7 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state domainResourceModel
+	var state protectedDomainModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -99,23 +105,88 @@ func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	statePwd := state.SmtpPassword
-	diags, notFound := refreshDomain(ctx, client, state.ID.ValueString(), &state)
+	stateSelector := state.DkimSelector
+	diags, notFound := refreshDomain(ctx, client, state.ID.ValueString(), &state.domainResourceModel)
 	if notFound {
 		log.Printf("[WARN] Mailgun domain %s not found, removing from state", state.ID.ValueString())
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.refreshDkimSelector(ctx, &state.domainResourceModel, stateSelector)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	state.SmtpPassword = statePwd
+	// Domains created before deletion_protection existed have it null in
+	// state; fill in the default so they do not plan an update.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+
+	imported, d := req.Private.GetKey(ctx, importedDkimAuthorityKey)
+	resp.Diagnostics.Append(d...)
+	if len(imported) > 0 {
+		resp.Diagnostics.Append(deriveForceDkimAuthority(ctx, &state.domainResourceModel)...)
+		// A domain without a parent on the account publishes its DKIM record
+		// under its own name either way; keep the default then.
+		if state.ForceDkimAuthority.ValueBool() {
+			domains, err := r.cfg.ListDomains(ctx, state.Region.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to list domains", err.Error())
+				return
+			}
+			names := make([]string, len(domains))
+			for i, d := range domains {
+				names[i] = d.Name
+			}
+			if !hasParentDomain(state.Name.ValueString(), names) {
+				state.ForceDkimAuthority = types.BoolValue(false)
+			}
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedDkimAuthorityKey, nil)...)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// deriveForceDkimAuthority fills force_dkim_authority of an imported domain,
+// which Mailgun does not return, from where its DKIM record is published.
+func deriveForceDkimAuthority(ctx context.Context, m *domainResourceModel) diag.Diagnostics {
+	var records []sendingRecordModel
+	diags := m.SendingRecordsSet.ElementsAs(ctx, &records, false)
+	if diags.HasError() {
+		return diags
+	}
+	names := make([]string, len(records))
+	for i, r := range records {
+		names[i] = r.Name.ValueString()
+	}
+	if self, ok := dkimAuthorityIsSelf(m.Name.ValueString(), names); ok {
+		m.ForceDkimAuthority = types.BoolValue(self)
+	}
+	return diags
+}
+
+// refreshDkimSelector keeps selector, the value held before refreshing m,
+// while it is still an active key; see keepActiveDkimSelector. The keys are
+// only listed when the DKIM record names another selector.
+func (r *domainResource) refreshDkimSelector(ctx context.Context, m *domainResourceModel, selector types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if selector.IsNull() || selector.IsUnknown() || selector.Equal(m.DkimSelector) || m.UseAutomaticSenderSecurity.ValueBool() {
+		return diags
+	}
+	keys, err := r.cfg.ListDkimKeys(ctx, m.Region.ValueString(), m.Name.ValueString())
+	if err != nil {
+		diags.AddError("Failed to read DKIM keys", err.Error())
+		return diags
+	}
+	keepActiveDkimSelector(m, selector.ValueString(), keys)
+	return diags
+}
+
 func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state domainResourceModel
+	var plan, state protectedDomainModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -135,6 +206,18 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 	}
+	if sel := plan.DkimSelector; !sel.Equal(state.DkimSelector) && sel.ValueString() != "" {
+		if err := client.UpdateDomainDkimSelector(ctx, name, sel.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to update DKIM selector", err.Error())
+			return
+		}
+	}
+	if auth := plan.ForceDkimAuthority; !auth.IsUnknown() && !auth.IsNull() && !auth.Equal(state.ForceDkimAuthority) {
+		if err := r.cfg.UpdateDomainDkimAuthority(ctx, plan.Region.ValueString(), name, auth.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Failed to update DKIM authority", err.Error())
+			return
+		}
+	}
 	if !plan.OpenTracking.Equal(state.OpenTracking) {
 		v := boolToYesNo(plan.OpenTracking.ValueBool())
 		if err := client.UpdateOpenTracking(ctx, name, v); err != nil {
@@ -149,18 +232,26 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 	}
-	if !plan.WebScheme.Equal(state.WebScheme) {
-		opts := mailgun.UpdateDomainOptions{WebScheme: plan.WebScheme.ValueString()}
+	if opts, changed := domainUpdateOptions(&plan.domainResourceModel, &state.domainResourceModel); changed {
 		if err := client.UpdateDomain(ctx, name, &opts); err != nil {
-			resp.Diagnostics.AddError("Failed to update web scheme", err.Error())
+			resp.Diagnostics.AddError("Failed to update domain", err.Error())
+			return
+		}
+	}
+	// mailgun-go's UpdateDomain cannot change spam_action and wildcard.
+	if settings, changed := domainSettingsUpdate(&plan.domainResourceModel, &state.domainResourceModel); changed {
+		if err := r.cfg.UpdateDomainSettings(ctx, plan.Region.ValueString(), name, settings); err != nil {
+			resp.Diagnostics.AddError("Failed to update domain", err.Error())
 			return
 		}
 	}
 
 	// Preserve smtp_password from plan (API never returns it).
 	planPwd := plan.SmtpPassword
-	diags, _ := refreshDomain(ctx, client, name, &plan)
+	planSelector := plan.DkimSelector
+	diags, _ := refreshDomain(ctx, client, name, &plan.domainResourceModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.refreshDkimSelector(ctx, &plan.domainResourceModel, planSelector)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -169,12 +260,20 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state domainResourceModel
+	var state protectedDomainModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Domain is protected from deletion",
+			fmt.Sprintf("Mailgun domain %q has deletion_protection enabled. Deleting it would also delete its routes, "+
+				"credentials, suppressions and history. Set deletion_protection = false and apply before destroying "+
+				"or replacing it.", state.ID.ValueString()))
+		return
+	}
+
 	client, err := r.cfg.GetClient(state.Region.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Mailgun client error", err.Error())
@@ -206,6 +305,39 @@ func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// domainUpdateOptions collects the changed arguments UpdateDomain accepts.
+func domainUpdateOptions(plan, state *domainResourceModel) (mailgun.UpdateDomainOptions, bool) {
+	var opts mailgun.UpdateDomainOptions
+	changed := false
+	if !plan.WebScheme.Equal(state.WebScheme) {
+		opts.WebScheme = plan.WebScheme.ValueString()
+		changed = true
+	}
+	if !plan.UseAutomaticSenderSecurity.Equal(state.UseAutomaticSenderSecurity) {
+		v := plan.UseAutomaticSenderSecurity.ValueBool()
+		opts.UseAutomaticSenderSecurity = &v
+		changed = true
+	}
+	return opts, changed
+}
+
+// domainSettingsUpdate collects the changed arguments that need
+// UpdateDomainSettings.
+func domainSettingsUpdate(plan, state *domainResourceModel) (mailgunpkg.DomainSettings, bool) {
+	var settings mailgunpkg.DomainSettings
+	changed := false
+	if !plan.SpamAction.Equal(state.SpamAction) {
+		settings.SpamAction = plan.SpamAction.ValueString()
+		changed = true
+	}
+	if !plan.Wildcard.Equal(state.Wildcard) {
+		v := plan.Wildcard.ValueBool()
+		settings.Wildcard = &v
+		changed = true
+	}
+	return settings, changed
+}
+
 // boolToYesNo converts a bool to the yes/no value expected by Mailgun
 // tracking endpoints.
 func boolToYesNo(b bool) string {
@@ -49,6 +49,7 @@ func (d *domainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			"use_automatic_sender_security": dsschema.BoolAttribute{Computed: true},
 			"sending_records_set":           dsSendingRecordsSetAttribute(),
 			"receiving_records_set":         dsReceivingRecordsSetAttribute(),
+			"dns_records":                   dsDNSRecordsAttribute(),
 		},
 	}
 }
@@ -94,6 +95,7 @@ func (d *domainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(deriveForceDkimAuthority(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -130,3 +132,19 @@ func dsReceivingRecordsSetAttribute() dsschema.SetNestedAttribute {
 		},
 	}
 }
+
+func dsDNSRecordsAttribute() dsschema.MapNestedAttribute {
+	return dsschema.MapNestedAttribute{
+		Computed: true,
+		NestedObject: dsschema.NestedAttributeObject{
+			Attributes: map[string]dsschema.Attribute{
+				"name":     dsschema.StringAttribute{Computed: true},
+				"fqdn":     dsschema.StringAttribute{Computed: true},
+				"type":     dsschema.StringAttribute{Computed: true},
+				"values":   dsschema.ListAttribute{Computed: true, ElementType: types.StringType},
+				"priority": dsschema.Int64Attribute{Computed: true},
+				"ttl":      dsschema.Int64Attribute{Computed: true},
+			},
+		},
+	}
+}
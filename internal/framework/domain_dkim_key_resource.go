@@ -0,0 +1,285 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ resource.Resource                = (*domainDkimKeyResource)(nil)
+	_ resource.ResourceWithImportState = (*domainDkimKeyResource)(nil)
+	_ resource.ResourceWithConfigure   = (*domainDkimKeyResource)(nil)
+)
+
+// NewDomainDkimKeyResource is the constructor registered with the framework
+// provider for mailgun_domain_dkim_key.
+func NewDomainDkimKeyResource() resource.Resource {
+	return &domainDkimKeyResource{}
+}
+
+// domainDkimKeyResource manages an additional DKIM key of a domain, so keys
+// can be rotated without replacing the domain.
+type domainDkimKeyResource struct {
+	cfg *mailgunpkg.Config
+}
+
+type domainDkimKeyResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Region         types.String `tfsdk:"region"`
+	Domain         types.String `tfsdk:"domain"`
+	Selector       types.String `tfsdk:"selector"`
+	KeySize        types.Int64  `tfsdk:"key_size"`
+	PrivateKey     types.String `tfsdk:"private_key"`
+	Active         types.Bool   `tfsdk:"active"`
+	DNSRecordName  types.String `tfsdk:"dns_record_name"`
+	DNSRecordType  types.String `tfsdk:"dns_record_type"`
+	DNSRecordValue types.String `tfsdk:"dns_record_value"`
+	Valid          types.String `tfsdk:"valid"`
+}
+
+func (r *domainDkimKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_dkim_key"
+}
+
+func (r *domainDkimKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	computedRecord := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("us"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"selector": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Length of the generated key, `1024` or `2048`. Conflicts with `private_key`. Read from the DNS record when not set.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.OneOf(1024, 2048),
+					int64validator.ConflictsWith(path.MatchRoot("private_key")),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "PEM encoded private key to upload instead of letting Mailgun generate one.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"dns_record_name":  computedRecord(),
+			"dns_record_type":  computedRecord(),
+			"dns_record_value": computedRecord(),
+			"valid":            schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (r *domainDkimKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+// ImportState accepts "domain:selector" (region defaults to "us") or
+// "region:domain:selector". The private key cannot be read back.
+func (r *domainDkimKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 3)
+	var region, domain, selector string
+	switch len(parts) {
+	case 2:
+		region, domain, selector = "us", parts[0], parts[1]
+	case 3:
+		region, domain, selector = parts[0], parts[1], parts[2]
+	default:
+		resp.Diagnostics.AddError("Invalid import ID",
+			"expected 'region:domain:selector' or 'domain:selector'")
+		return
+	}
+	id := fmt.Sprintf("%s:%s:%s", region, domain, selector)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("selector"), selector)...)
+}
+
+func (r *domainDkimKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan domainDkimKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, domain, selector := plan.Region.ValueString(), plan.Domain.ValueString(), plan.Selector.ValueString()
+	key, err := r.cfg.CreateDkimKey(ctx, region, mailgunpkg.CreateDkimKeyOptions{
+		SigningDomain: domain,
+		Selector:      selector,
+		Bits:          int(plan.KeySize.ValueInt64()),
+		PEM:           plan.PrivateKey.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create DKIM key", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", region, domain, selector))
+	log.Printf("[INFO] Create DKIM key ID: %s", plan.ID.ValueString())
+
+	// Save the key before changing its state: if activation fails, Terraform
+	// taints the tracked key instead of orphaning it.
+	active := plan.Active.ValueBool()
+	applyDkimKey(key, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if key.DNSRecord.Active != active {
+		if err := r.cfg.SetDkimKeyActive(ctx, region, domain, selector, active); err != nil {
+			resp.Diagnostics.AddError("Failed to change DKIM key state", err.Error())
+			return
+		}
+	}
+
+	key, err = r.cfg.GetDkimKey(ctx, region, domain, selector)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to refresh DKIM key", err.Error())
+		return
+	}
+	applyDkimKey(key, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *domainDkimKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state domainDkimKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.cfg.GetDkimKey(ctx, state.Region.ValueString(), state.Domain.ValueString(), state.Selector.ValueString())
+	if err != nil {
+		if mailgunpkg.IsNotFound(err) {
+			log.Printf("[WARN] Mailgun DKIM key %s not found, removing from state", state.ID.ValueString())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read DKIM key", err.Error())
+		return
+	}
+
+	applyDkimKey(key, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update can only change active; every other argument forces replacement.
+func (r *domainDkimKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan domainDkimKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, domain, selector := plan.Region.ValueString(), plan.Domain.ValueString(), plan.Selector.ValueString()
+	if err := r.cfg.SetDkimKeyActive(ctx, region, domain, selector, plan.Active.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Failed to change DKIM key state", err.Error())
+		return
+	}
+	log.Printf("[INFO] Update DKIM key ID: %s, active: %t", plan.ID.ValueString(), plan.Active.ValueBool())
+
+	key, err := r.cfg.GetDkimKey(ctx, region, domain, selector)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to refresh DKIM key", err.Error())
+		return
+	}
+	applyDkimKey(key, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *domainDkimKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state domainDkimKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.cfg.DeleteDkimKey(ctx, state.Region.ValueString(), state.Domain.ValueString(), state.Selector.ValueString())
+	if err != nil && !mailgunpkg.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete DKIM key", err.Error())
+		return
+	}
+	log.Printf("[INFO] Delete DKIM key ID: %s", state.ID.ValueString())
+}
+
+func applyDkimKey(key mailgunpkg.DkimKey, m *domainDkimKeyResourceModel) {
+	m.Active = types.BoolValue(key.DNSRecord.Active)
+	m.DNSRecordName = types.StringValue(key.DNSRecord.Name)
+	m.DNSRecordType = types.StringValue(key.DNSRecord.RecordType)
+	m.DNSRecordValue = types.StringValue(key.DNSRecord.Value)
+	m.Valid = types.StringValue(key.DNSRecord.Valid)
+	// Imported and uploaded keys get their length from the public key.
+	if size, ok := dkimKeySize(key.DNSRecord.Value); ok {
+		m.KeySize = types.Int64Value(size)
+	} else if m.KeySize.IsUnknown() {
+		m.KeySize = types.Int64Null()
+	}
+}
@@ -0,0 +1,122 @@
+package framework_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+func TestAccMailgunDomainDkimKey_Basic(t *testing.T) {
+	id, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraform.%s.com", id)
+	resourceName := "mailgun_domain_dkim_key.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunDomainDkimKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunDomainDkimKeyConfig(domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "us:"+domain+":tf2"),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "dns_record_name", "tf2._domainkey."+domain),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_record_value"),
+				),
+			},
+			{
+				Config: testAccMailgunDomainDkimKeyConfig(domain, true),
+				Check:  resource.TestCheckResourceAttr(resourceName, "active", "true"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Activating a key of another size and selector must leave the domain's
+// configured dkim_selector and dkim_key_size alone.
+func TestAccMailgunDomainDkimKey_Rotate(t *testing.T) {
+	id, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraform.%s.com", id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunDomainDkimKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunDomainDkimKeyRotateConfig(domain),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mailgun_domain.foobar", "dkim_selector", "tf1"),
+					resource.TestCheckResourceAttr("mailgun_domain.foobar", "dkim_key_size", "1024"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMailgunDomainDkimKeyDestroy(s *terraform.State) error {
+	cfg := &mailgunpkg.Config{APIKey: os.Getenv("MAILGUN_API_KEY")}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mailgun_domain_dkim_key" {
+			continue
+		}
+		attrs := rs.Primary.Attributes
+		key, err := cfg.GetDkimKey(context.Background(), attrs["region"], attrs["domain"], attrs["selector"])
+		if err == nil {
+			return fmt.Errorf("DKIM key still exists: %#v", key)
+		}
+	}
+	return nil
+}
+
+func testAccMailgunDomainDkimKeyConfig(domain string, active bool) string {
+	return fmt.Sprintf(`
+resource "mailgun_domain" "foobar" {
+  name        = "%s"
+  spam_action = "disabled"
+  region      = "us"
+}
+
+resource "mailgun_domain_dkim_key" "foobar" {
+  domain   = mailgun_domain.foobar.name
+  selector = "tf2"
+  key_size = 2048
+  active   = %t
+}`, domain, active)
+}
+
+func testAccMailgunDomainDkimKeyRotateConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "mailgun_domain" "foobar" {
+  name          = "%s"
+  region        = "us"
+  dkim_selector = "tf1"
+  dkim_key_size = 1024
+}
+
+resource "mailgun_domain_dkim_key" "foobar" {
+  domain   = mailgun_domain.foobar.name
+  selector = "tf2"
+  key_size = 2048
+  active   = true
+}`, domain)
+}
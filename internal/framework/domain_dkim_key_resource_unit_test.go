@@ -0,0 +1,26 @@
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestApplyDkimKey_KeySize(t *testing.T) {
+	key := dkimTestKey("s2", true, "valid")
+	key.DNSRecord.Value = testDkimValue(t, 1024)
+
+	// Import leaves key_size null; it must match a configured 1024 after Read.
+	m := domainDkimKeyResourceModel{KeySize: types.Int64Null()}
+	applyDkimKey(key, &m)
+	if m.KeySize.ValueInt64() != 1024 {
+		t.Errorf("key_size = %s, want 1024", m.KeySize)
+	}
+
+	key.DNSRecord.Value = "k=rsa; p=unparsable"
+	m = domainDkimKeyResourceModel{KeySize: types.Int64Unknown()}
+	applyDkimKey(key, &m)
+	if !m.KeySize.IsNull() {
+		t.Errorf("key_size = %s, want null", m.KeySize)
+	}
+}
@@ -0,0 +1,183 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+// A rotation moves through two steps, each taken by one apply:
+//
+//  1. once rotation_period has elapsed since rotated_at, a new inactive key
+//     is created and exposed as pending_selector so its record can be
+//     published next to the active one;
+//  2. once Mailgun reports the pending record as valid, the pending key is
+//     activated and the old key is deactivated and deleted.
+type dkimRotationStep int
+
+const (
+	dkimRotationNone dkimRotationStep = iota
+	dkimRotationStart
+	dkimRotationComplete
+)
+
+// nextDkimRotationStep returns the step the next apply should take.
+func nextDkimRotationStep(rotatedAt time.Time, period time.Duration, pending string, pendingValid bool, now time.Time) dkimRotationStep {
+	if pending != "" {
+		if pendingValid {
+			return dkimRotationComplete
+		}
+		return dkimRotationNone
+	}
+	if !now.Before(rotatedAt.Add(period)) {
+		return dkimRotationStart
+	}
+	return dkimRotationNone
+}
+
+// parseRotationPeriod accepts Go durations ("4380h") and whole days ("180d").
+func parseRotationPeriod(s string) (time.Duration, error) {
+	var d time.Duration
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a number of days", s)
+		}
+		d = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return 0, fmt.Errorf("%q is not a duration such as \"180d\" or \"4380h\"", s)
+		}
+	}
+	if d < 24*time.Hour {
+		return 0, fmt.Errorf("%q is shorter than a day; DNS changes need time to propagate", s)
+	}
+	return d, nil
+}
+
+// rotationSelector names the key created by a rotation started at now.
+func rotationSelector(prefix string, now time.Time) string {
+	return prefix + now.UTC().Format("200601021504")
+}
+
+// dkimRotationRecordModel mirrors a records element.
+type dkimRotationRecordModel struct {
+	Name  types.String `tfsdk:"name"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+	Valid types.String `tfsdk:"valid"`
+}
+
+func dkimRotationRecordAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":  types.StringType,
+		"type":  types.StringType,
+		"value": types.StringType,
+		"valid": types.StringType,
+	}
+}
+
+// defaultDkimRotationKeySize is used for new keys when key_size is unset and
+// the length of the active key cannot be read from its record.
+const defaultDkimRotationKeySize = 2048
+
+// applyDkimRotationKeys refreshes m from the keys Mailgun lists for the
+// domain. An active key that disappeared is replaced by whichever key is
+// active now, and a pending key that disappeared is forgotten. An unset
+// key_size takes the length of the active key, so rotating keeps it.
+func applyDkimRotationKeys(ctx context.Context, m *dkimRotationResourceModel, keys []mailgunpkg.DkimKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+	bySelector := map[string]mailgunpkg.DkimKey{}
+	var active []string
+	for _, k := range keys {
+		bySelector[k.Selector] = k
+		if k.DNSRecord.Active {
+			active = append(active, k.Selector)
+		}
+	}
+	sort.Strings(active)
+
+	if _, ok := bySelector[m.ActiveSelector.ValueString()]; !ok {
+		if len(active) == 0 {
+			diags.AddError("No active DKIM key",
+				fmt.Sprintf("Mailgun lists no active DKIM key for %q", m.Domain.ValueString()))
+			return diags
+		}
+		m.ActiveSelector = types.StringValue(active[0])
+	}
+	if _, ok := bySelector[m.PendingSelector.ValueString()]; !ok {
+		m.PendingSelector = types.StringNull()
+	}
+	if m.KeySize.IsNull() || m.KeySize.IsUnknown() {
+		m.KeySize = types.Int64Value(defaultDkimRotationKeySize)
+		if size, ok := dkimKeySize(bySelector[m.ActiveSelector.ValueString()].DNSRecord.Value); ok {
+			m.KeySize = types.Int64Value(size)
+		}
+	}
+
+	records := map[string]dkimRotationRecordModel{}
+	for _, sel := range []types.String{m.ActiveSelector, m.PendingSelector} {
+		if sel.IsNull() {
+			continue
+		}
+		r := bySelector[sel.ValueString()].DNSRecord
+		records[sel.ValueString()] = dkimRotationRecordModel{
+			Name:  types.StringValue(r.Name),
+			Type:  types.StringValue(r.RecordType),
+			Value: types.StringValue(r.Value),
+			Valid: types.StringValue(r.Valid),
+		}
+	}
+	v, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: dkimRotationRecordAttrTypes()}, records)
+	diags.Append(d...)
+	if !diags.HasError() {
+		m.Records = v
+	}
+	return diags
+}
+
+// pendingRecordValid reports whether state holds a pending key whose record
+// Mailgun has verified.
+func pendingRecordValid(ctx context.Context, m *dkimRotationResourceModel) bool {
+	if m.PendingSelector.IsNull() || m.Records.IsNull() || m.Records.IsUnknown() {
+		return false
+	}
+	var records map[string]dkimRotationRecordModel
+	if d := m.Records.ElementsAs(ctx, &records, false); d.HasError() {
+		return false
+	}
+	return records[m.PendingSelector.ValueString()].Valid.ValueString() == "valid"
+}
+
+var _ validator.String = rotationPeriodValidator{}
+
+// rotationPeriodValidator checks rotation_period with parseRotationPeriod.
+type rotationPeriodValidator struct{}
+
+func (v rotationPeriodValidator) Description(_ context.Context) string {
+	return "value must be a duration of at least a day, such as \"180d\" or \"4380h\""
+}
+
+func (v rotationPeriodValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rotationPeriodValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := parseRotationPeriod(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid rotation period", err.Error())
+	}
+}
@@ -0,0 +1,364 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ resource.Resource                = (*domainDkimRotationResource)(nil)
+	_ resource.ResourceWithImportState = (*domainDkimRotationResource)(nil)
+	_ resource.ResourceWithConfigure   = (*domainDkimRotationResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*domainDkimRotationResource)(nil)
+)
+
+// NewDomainDkimRotationResource is the constructor registered with the
+// framework provider for mailgun_domain_dkim_rotation.
+func NewDomainDkimRotationResource() resource.Resource {
+	return &domainDkimRotationResource{}
+}
+
+// domainDkimRotationResource rotates the DKIM key of a domain on a schedule.
+// The steps are described in domain_dkim_rotation.go.
+type domainDkimRotationResource struct {
+	cfg *mailgunpkg.Config
+}
+
+type dkimRotationResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Region          types.String `tfsdk:"region"`
+	Domain          types.String `tfsdk:"domain"`
+	RotationPeriod  types.String `tfsdk:"rotation_period"`
+	SelectorPrefix  types.String `tfsdk:"selector_prefix"`
+	KeySize         types.Int64  `tfsdk:"key_size"`
+	ActiveSelector  types.String `tfsdk:"active_selector"`
+	PendingSelector types.String `tfsdk:"pending_selector"`
+	RotatedAt       types.String `tfsdk:"rotated_at"`
+	Records         types.Map    `tfsdk:"records"`
+}
+
+func (r *domainDkimRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_dkim_rotation"
+}
+
+func (r *domainDkimRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	computedString := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": computedString(),
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("us"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_period": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "How long a key stays active before the next rotation starts, e.g. `180d` or `4380h`.",
+				Validators: []validator.String{
+					rotationPeriodValidator{},
+				},
+			},
+			"selector_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("tf"),
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+				},
+			},
+			"key_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Length of new keys. Defaults to the length of the key active when the resource is created or imported.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.OneOf(1024, 2048),
+				},
+			},
+			"active_selector":  computedString(),
+			"pending_selector": computedString(),
+			"rotated_at":       computedString(),
+			"records": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "DKIM records to publish, keyed by selector. Holds both keys while a rotation is pending.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":  schema.StringAttribute{Computed: true},
+						"type":  schema.StringAttribute{Computed: true},
+						"value": schema.StringAttribute{Computed: true},
+						"valid": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *domainDkimRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+// ImportState accepts "region:domain" or a bare domain (region defaults to
+// "us"). The rotation clock starts at import time.
+func (r *domainDkimRotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	region, domain := "us", req.ID
+	if parts := strings.SplitN(req.ID, ":", 2); len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		region, domain = parts[0], parts[1]
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), region+":"+domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rotated_at"), time.Now().UTC().Format(time.RFC3339))...)
+}
+
+// ModifyPlan plans an update when a rotation step is due so that the next
+// apply takes it. Computed attributes are only marked unknown here; Update
+// acts only when they are.
+func (r *domainDkimRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dkimRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.RotationPeriod.IsUnknown() {
+		return
+	}
+
+	if dkimRotationStepFor(ctx, &plan, &state, time.Now()) == dkimRotationNone {
+		return
+	}
+	plan.ActiveSelector = types.StringUnknown()
+	plan.PendingSelector = types.StringUnknown()
+	plan.RotatedAt = types.StringUnknown()
+	plan.Records = types.MapUnknown(plan.Records.ElementType(ctx))
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// dkimRotationStepFor evaluates nextDkimRotationStep for the configured
+// period against the rotation state.
+func dkimRotationStepFor(ctx context.Context, plan, state *dkimRotationResourceModel, now time.Time) dkimRotationStep {
+	period, err := parseRotationPeriod(plan.RotationPeriod.ValueString())
+	if err != nil {
+		return dkimRotationNone
+	}
+	rotatedAt, err := time.Parse(time.RFC3339, state.RotatedAt.ValueString())
+	if err != nil {
+		return dkimRotationNone
+	}
+	return nextDkimRotationStep(rotatedAt, period, state.PendingSelector.ValueString(), pendingRecordValid(ctx, state), now)
+}
+
+// Create adopts the key that is currently active; the first rotation starts
+// once rotation_period has elapsed.
+func (r *domainDkimRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dkimRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Region.ValueString() + ":" + plan.Domain.ValueString())
+	plan.ActiveSelector = types.StringNull()
+	plan.PendingSelector = types.StringNull()
+	plan.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Create DKIM rotation ID: %s, active selector: %s", plan.ID.ValueString(), plan.ActiveSelector.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *domainDkimRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dkimRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := r.cfg.ListDkimKeys(ctx, state.Region.ValueString(), state.Domain.ValueString())
+	if err != nil {
+		if mailgunpkg.IsNotFound(err) {
+			log.Printf("[WARN] Mailgun domain %s not found, removing DKIM rotation from state", state.Domain.ValueString())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read DKIM keys", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(applyDkimRotationKeys(ctx, &state, keys)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *domainDkimRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dkimRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only take a step ModifyPlan announced; otherwise the result would not
+	// match the plan.
+	step := dkimRotationNone
+	if plan.RotatedAt.IsUnknown() {
+		step = dkimRotationStepFor(ctx, &plan, &state, time.Now())
+	}
+	plan.ActiveSelector = state.ActiveSelector
+	plan.PendingSelector = state.PendingSelector
+	plan.RotatedAt = state.RotatedAt
+	plan.Records = state.Records
+
+	if step == dkimRotationNone {
+		// Only settings changed; keep the refreshed state as planned.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	region, domain := plan.Region.ValueString(), plan.Domain.ValueString()
+	switch step {
+	case dkimRotationStart:
+		selector := rotationSelector(plan.SelectorPrefix.ValueString(), time.Now())
+		key, err := r.cfg.CreateDkimKey(ctx, region, mailgunpkg.CreateDkimKeyOptions{
+			SigningDomain: domain,
+			Selector:      selector,
+			Bits:          int(plan.KeySize.ValueInt64()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create DKIM key", err.Error())
+			return
+		}
+		// Track the new key before deactivating it so a failure below does
+		// not leave it unknown to Terraform.
+		plan.PendingSelector = types.StringValue(selector)
+		if key.DNSRecord.Active {
+			if err := r.cfg.SetDkimKeyActive(ctx, region, domain, selector, false); err != nil {
+				resp.Diagnostics.AddError("Failed to deactivate new DKIM key", err.Error())
+				resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+				return
+			}
+		}
+		log.Printf("[INFO] Started DKIM rotation of %s to selector %s", domain, selector)
+	case dkimRotationComplete:
+		resp.Diagnostics.Append(r.completeRotation(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// completeRotation activates the pending key and retires the old one.
+func (r *domainDkimRotationResource) completeRotation(ctx context.Context, m *dkimRotationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	region, domain := m.Region.ValueString(), m.Domain.ValueString()
+	oldSel, newSel := m.ActiveSelector.ValueString(), m.PendingSelector.ValueString()
+
+	if err := r.cfg.SetDkimKeyActive(ctx, region, domain, newSel, true); err != nil {
+		diags.AddError("Failed to activate DKIM key", err.Error())
+		return diags
+	}
+	m.ActiveSelector = types.StringValue(newSel)
+	m.PendingSelector = types.StringNull()
+	m.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	if err := r.cfg.SetDkimKeyActive(ctx, region, domain, oldSel, false); err != nil && !mailgunpkg.IsNotFound(err) {
+		diags.AddError("Failed to deactivate old DKIM key", err.Error())
+		return diags
+	}
+	if err := r.cfg.DeleteDkimKey(ctx, region, domain, oldSel); err != nil && !mailgunpkg.IsNotFound(err) {
+		diags.AddError("Failed to delete old DKIM key", err.Error())
+		return diags
+	}
+	log.Printf("[INFO] Completed DKIM rotation of %s from selector %s to %s", domain, oldSel, newSel)
+	return diags
+}
+
+// Delete stops managing the rotation. The active key is left in place; a
+// pending key was never used for signing and is removed.
+func (r *domainDkimRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dkimRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if pending := state.PendingSelector.ValueString(); pending != "" {
+		err := r.cfg.DeleteDkimKey(ctx, state.Region.ValueString(), state.Domain.ValueString(), pending)
+		if err != nil && !mailgunpkg.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to delete pending DKIM key", err.Error())
+			return
+		}
+	}
+	log.Printf("[INFO] Delete DKIM rotation ID: %s", state.ID.ValueString())
+}
+
+func (r *domainDkimRotationResource) refresh(ctx context.Context, m *dkimRotationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	keys, err := r.cfg.ListDkimKeys(ctx, m.Region.ValueString(), m.Domain.ValueString())
+	if err != nil {
+		diags.AddError("Failed to read DKIM keys", err.Error())
+		return diags
+	}
+	return applyDkimRotationKeys(ctx, m, keys)
+}
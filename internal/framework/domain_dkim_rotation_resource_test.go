@@ -0,0 +1,55 @@
+package framework_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMailgunDomainDkimRotation_Basic(t *testing.T) {
+	id, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraform.%s.com", id)
+	resourceName := "mailgun_domain_dkim_rotation.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunDomainDkimRotationConfig(domain, "180d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "us:"+domain),
+					resource.TestCheckResourceAttrSet(resourceName, "active_selector"),
+					resource.TestCheckNoResourceAttr(resourceName, "pending_selector"),
+					resource.TestCheckResourceAttrSet(resourceName, "rotated_at"),
+					resource.TestCheckResourceAttr(resourceName, "records.%", "1"),
+				),
+			},
+			{
+				// Changing the schedule alone does not rotate.
+				Config: testAccMailgunDomainDkimRotationConfig(domain, "90d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "90d"),
+					resource.TestCheckNoResourceAttr(resourceName, "pending_selector"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMailgunDomainDkimRotationConfig(domain, period string) string {
+	return fmt.Sprintf(`
+resource "mailgun_domain" "foobar" {
+  name        = "%s"
+  spam_action = "disabled"
+  region      = "us"
+}
+
+resource "mailgun_domain_dkim_rotation" "foobar" {
+  domain          = mailgun_domain.foobar.name
+  rotation_period = "%s"
+}`, domain, period)
+}
@@ -0,0 +1,117 @@
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+func TestParseRotationPeriod(t *testing.T) {
+	cases := map[string]time.Duration{
+		"180d":  180 * 24 * time.Hour,
+		"4380h": 4380 * time.Hour,
+		"1d":    24 * time.Hour,
+	}
+	for in, want := range cases {
+		got, err := parseRotationPeriod(in)
+		if err != nil || got != want {
+			t.Errorf("parseRotationPeriod(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "six months", "1.5d", "12h", "-3d"} {
+		if _, err := parseRotationPeriod(in); err == nil {
+			t.Errorf("parseRotationPeriod(%q) succeeded, want an error", in)
+		}
+	}
+}
+
+func TestNextDkimRotationStep(t *testing.T) {
+	rotatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	period := 180 * 24 * time.Hour
+	before := rotatedAt.Add(period - time.Hour)
+	after := rotatedAt.Add(period)
+
+	cases := []struct {
+		name         string
+		pending      string
+		pendingValid bool
+		now          time.Time
+		want         dkimRotationStep
+	}{
+		{"not due", "", false, before, dkimRotationNone},
+		{"due", "", false, after, dkimRotationStart},
+		{"pending unverified", "tf202607010000", false, after, dkimRotationNone},
+		{"pending verified", "tf202607010000", true, after, dkimRotationComplete},
+		{"pending verified early", "tf202607010000", true, before, dkimRotationComplete},
+	}
+	for _, tc := range cases {
+		if got := nextDkimRotationStep(rotatedAt, period, tc.pending, tc.pendingValid, tc.now); got != tc.want {
+			t.Errorf("%s: step = %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRotationSelector(t *testing.T) {
+	now := time.Date(2026, 10, 19, 14, 5, 9, 0, time.FixedZone("CEST", 2*60*60))
+	if got := rotationSelector("tf", now); got != "tf202610191205" {
+		t.Errorf("selector = %q", got)
+	}
+}
+
+func dkimTestKey(selector string, active bool, valid string) mailgunpkg.DkimKey {
+	return mailgunpkg.DkimKey{
+		SigningDomain: "mg.example.com",
+		Selector:      selector,
+		DNSRecord: mailgunpkg.DkimDNSRecord{
+			Active:     active,
+			Name:       selector + "._domainkey.mg.example.com",
+			RecordType: "TXT",
+			Valid:      valid,
+			Value:      "k=rsa; p=" + selector,
+		},
+	}
+}
+
+func TestApplyDkimRotationKeys(t *testing.T) {
+	ctx := context.Background()
+	m := dkimRotationResourceModel{
+		Domain:          types.StringValue("mg.example.com"),
+		ActiveSelector:  types.StringValue("s1"),
+		PendingSelector: types.StringValue("tf2"),
+	}
+	keys := []mailgunpkg.DkimKey{dkimTestKey("s1", true, "valid"), dkimTestKey("tf2", false, "valid")}
+	keys[0].DNSRecord.Value = testDkimValue(t, 1024)
+	if d := applyDkimRotationKeys(ctx, &m, keys); d.HasError() {
+		t.Fatalf("apply: %v", d)
+	}
+	if m.KeySize.ValueInt64() != 1024 {
+		t.Errorf("key_size = %s, want the length of the active key", m.KeySize)
+	}
+	if n := len(m.Records.Elements()); n != 2 {
+		t.Fatalf("records has %d entries, want 2", n)
+	}
+	if !pendingRecordValid(ctx, &m) {
+		t.Error("pending record should be valid")
+	}
+
+	// Keys removed out of band: the pending key is forgotten and the
+	// currently active key is adopted.
+	keys = []mailgunpkg.DkimKey{dkimTestKey("manual", true, "unknown")}
+	if d := applyDkimRotationKeys(ctx, &m, keys); d.HasError() {
+		t.Fatalf("apply: %v", d)
+	}
+	if m.ActiveSelector.ValueString() != "manual" || !m.PendingSelector.IsNull() {
+		t.Errorf("active = %s, pending = %s", m.ActiveSelector, m.PendingSelector)
+	}
+	if pendingRecordValid(ctx, &m) {
+		t.Error("no pending key left")
+	}
+
+	if d := applyDkimRotationKeys(ctx, &m, nil); !d.HasError() {
+		t.Error("expected an error without an active key")
+	}
+}
@@ -0,0 +1,244 @@
+package framework
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+)
+
+// mailgunDNSHost returns the Mailgun host that DNS records of a domain in
+// region point at.
+func mailgunDNSHost(region string) string {
+	if strings.EqualFold(region, "eu") {
+		return "eu.mailgun.org"
+	}
+	return "mailgun.org"
+}
+
+// sendingRecordID returns the id used for a sending record in
+// sending_records_set. DKIM records of domains without automatic sender
+// security get a selector-independent id so changing the selector does not
+// churn the set element. An empty recordName stands for a DKIM record whose
+// selector is not known yet.
+func sendingRecordID(domain, recordName string, automaticSenderSecurity bool) string {
+	isDKIM := recordName == "" || strings.Contains(recordName, "._domainkey.")
+	if isDKIM && !automaticSenderSecurity {
+		return "_domainkey." + domain
+	}
+	return recordName
+}
+
+// predictDomainRecords returns the DNS records Mailgun asks for when a domain
+// is created with the given settings, in the shape of a GetDomainResponse.
+// Values Mailgun generates on creation (the DKIM public key or, with
+// automatic sender security, the DKIM CNAME targets) are left empty, as is
+// the DKIM record name when no selector is given.
+func predictDomainRecords(name, region, dkimSelector string, automaticSenderSecurity bool) (sending, receiving []mtypes.DNSRecord) {
+	host := mailgunDNSHost(region)
+
+	sending = append(sending, mtypes.DNSRecord{
+		Name:       name,
+		RecordType: "TXT",
+		Value:      "v=spf1 include:" + host + " ~all",
+	})
+	if automaticSenderSecurity {
+		for _, sel := range []string{"pdk1", "pdk2"} {
+			sending = append(sending, mtypes.DNSRecord{Name: sel + "._domainkey." + name, RecordType: "CNAME"})
+		}
+	} else {
+		dkim := mtypes.DNSRecord{RecordType: "TXT"}
+		if dkimSelector != "" {
+			dkim.Name = dkimSelector + "._domainkey." + name
+		}
+		sending = append(sending, dkim)
+	}
+	sending = append(sending, mtypes.DNSRecord{
+		Name:       "email." + name,
+		RecordType: "CNAME",
+		Value:      host,
+	})
+
+	for _, mx := range []string{"mxa.", "mxb."} {
+		receiving = append(receiving, mtypes.DNSRecord{
+			Priority:   "10",
+			RecordType: "MX",
+			Value:      mx + host,
+		})
+	}
+	return sending, receiving
+}
+
+// dnsRecordTTL is the TTL suggested in dns_records. Mailgun does not
+// prescribe one; an hour keeps verification reasonably quick.
+const dnsRecordTTL = 3600
+
+// dnsRecordPurpose classifies a record Mailgun asks for. It returns "" for
+// records dns_records does not expose.
+func dnsRecordPurpose(r mtypes.DNSRecord) string {
+	switch {
+	case r.RecordType == "MX":
+		return "mx"
+	case strings.Contains(r.Name, "._domainkey."):
+		return "dkim"
+	case r.RecordType == "TXT" && strings.HasPrefix(r.Value, "v=spf1"):
+		return "spf"
+	case r.RecordType == "TXT" && strings.HasPrefix(r.Name, "_dmarc."):
+		return "dmarc"
+	case r.RecordType == "CNAME":
+		return "tracking_cname"
+	}
+	return ""
+}
+
+// relativeRecordName returns fqdn relative to domain, using "@" for the
+// domain itself.
+func relativeRecordName(domain, fqdn string) string {
+	fqdn = strings.TrimSuffix(fqdn, ".")
+	if fqdn == "" || strings.EqualFold(fqdn, domain) {
+		return "@"
+	}
+	if rel, ok := strings.CutSuffix(fqdn, "."+domain); ok {
+		return rel
+	}
+	return fqdn
+}
+
+// domainDNSRecords groups the sending and receiving records of domain into
+// one entry per name and type, keyed by purpose ("spf", "mx") so keys are
+// stable between reads. The first DKIM TXT record, the one Mailgun signs
+// with, is keyed "dkim"; any other DKIM record, such as the CNAMEs of automatic sender
+// security, is keyed by its selector ("dkim_pdk1") so another key appearing
+// never renames an existing entry.
+func domainDNSRecords(domain string, sending, receiving []mtypes.DNSRecord) map[string]dnsRecordModel {
+	signing := ""
+	for _, r := range sending {
+		if sel, _, found := strings.Cut(r.Name, "._domainkey."); found && r.RecordType == "TXT" {
+			signing = sel
+			break
+		}
+	}
+
+	type group struct {
+		fqdn, recordType string
+		values           []string
+		priority         *int64
+	}
+	groups := map[string]*group{}
+	for _, r := range append(append([]mtypes.DNSRecord{}, sending...), receiving...) {
+		key := dnsRecordPurpose(r)
+		if key == "" {
+			continue
+		}
+		fqdn := strings.TrimSuffix(r.Name, ".")
+		if fqdn == "" {
+			fqdn = domain
+		}
+		if sel, _, _ := strings.Cut(fqdn, "._domainkey."); key == "dkim" && (sel != signing || r.RecordType != "TXT") {
+			key = "dkim_" + sel
+		}
+		g, ok := groups[key]
+		if !ok {
+			g = &group{fqdn: fqdn, recordType: r.RecordType}
+			groups[key] = g
+		}
+		g.values = append(g.values, r.Value)
+		// Mailgun gives all of its MX hosts the same priority; keep the
+		// lowest should that ever change.
+		if p, err := strconv.ParseInt(r.Priority, 10, 64); err == nil && (g.priority == nil || p < *g.priority) {
+			g.priority = &p
+		}
+	}
+
+	out := make(map[string]dnsRecordModel, len(groups))
+	for key, g := range groups {
+		sort.Strings(g.values)
+		values := make([]attr.Value, len(g.values))
+		for i, v := range g.values {
+			values[i] = types.StringValue(v)
+		}
+		m := dnsRecordModel{
+			Name:     types.StringValue(relativeRecordName(domain, g.fqdn)),
+			FQDN:     types.StringValue(g.fqdn),
+			Type:     types.StringValue(g.recordType),
+			Values:   types.ListValueMust(types.StringType, values),
+			Priority: types.Int64Null(),
+			TTL:      types.Int64Value(dnsRecordTTL),
+		}
+		if g.priority != nil {
+			m.Priority = types.Int64Value(*g.priority)
+		}
+		out[key] = m
+	}
+	return out
+}
+
+// dkimFromRecords returns the selector and key size of the DKIM TXT record
+// among the sending records of domain. Domains with automatic sender
+// security publish CNAMEs to Mailgun-managed keys instead and yield nothing.
+func dkimFromRecords(domain string, sending []mtypes.DNSRecord) (selector string, keySize int64, ok bool) {
+	for _, r := range sending {
+		sel, found := strings.CutSuffix(r.Name, "._domainkey."+domain)
+		if !found || sel == "" || r.RecordType != "TXT" {
+			continue
+		}
+		size, _ := dkimKeySize(r.Value)
+		return sel, size, true
+	}
+	return "", 0, false
+}
+
+// dkimKeySize returns the RSA modulus length of the public key in a DKIM
+// TXT value such as "k=rsa; p=MIGfMA0...".
+func dkimKeySize(value string) (int64, bool) {
+	for _, tag := range strings.Split(value, ";") {
+		k, v, _ := strings.Cut(strings.TrimSpace(tag), "=")
+		if k != "p" {
+			continue
+		}
+		der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(v), ""))
+		if err != nil {
+			return 0, false
+		}
+		pub, err := x509.ParsePKIXPublicKey(der)
+		if err != nil {
+			return 0, false
+		}
+		if rsaKey, ok := pub.(*rsa.PublicKey); ok {
+			return int64(rsaKey.N.BitLen()), true
+		}
+		return 0, false
+	}
+	return 0, false
+}
+
+// dkimAuthorityIsSelf reports whether the DKIM records of domain are
+// published under the domain itself rather than under the domain whose DKIM
+// authority it shares. ok is false when there is no DKIM record.
+func dkimAuthorityIsSelf(domain string, recordNames []string) (self, ok bool) {
+	for _, name := range recordNames {
+		if !strings.Contains(name, "._domainkey.") {
+			continue
+		}
+		return strings.HasSuffix(name, "._domainkey."+domain), true
+	}
+	return false, false
+}
+
+// hasParentDomain reports whether names holds a parent of domain, such as
+// example.com for mg.example.com. Without one on the account, a domain is
+// its own DKIM authority whatever force_dkim_authority says.
+func hasParentDomain(domain string, names []string) bool {
+	for _, name := range names {
+		if strings.HasSuffix(domain, "."+name) {
+			return true
+		}
+	}
+	return false
+}
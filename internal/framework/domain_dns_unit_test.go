@@ -0,0 +1,231 @@
+package framework
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+func TestRelativeRecordName(t *testing.T) {
+	cases := map[string]string{
+		"mg.example.com":                "@",
+		"":                              "@",
+		"email.mg.example.com":          "email",
+		"s1._domainkey.mg.example.com.": "s1._domainkey",
+		"other.example.org":             "other.example.org",
+		"notmg.example.com":             "notmg.example.com",
+	}
+	for in, want := range cases {
+		if got := relativeRecordName("mg.example.com", in); got != want {
+			t.Errorf("relativeRecordName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func dnsRecordValues(m dnsRecordModel) []string {
+	var out []string
+	for _, v := range m.Values.Elements() {
+		out = append(out, v.(types.String).ValueString())
+	}
+	return out
+}
+
+func TestDomainDNSRecordsMap(t *testing.T) {
+	sending, receiving := predictDomainRecords("mg.example.com", "us", "s1", false)
+	sending[1].Value = "k=rsa; p=abc"
+	// Mailgun may list MX records in any order; keys must not depend on it.
+	receiving[0], receiving[1] = receiving[1], receiving[0]
+
+	got := domainDNSRecords("mg.example.com", sending, receiving)
+	if len(got) != 4 {
+		t.Fatalf("got %d records, want 4: %v", len(got), got)
+	}
+
+	spf := got["spf"]
+	if spf.Name.ValueString() != "@" || spf.FQDN.ValueString() != "mg.example.com" ||
+		spf.Type.ValueString() != "TXT" || !spf.Priority.IsNull() || spf.TTL.ValueInt64() != dnsRecordTTL {
+		t.Errorf("unexpected spf record %+v", spf)
+	}
+	if dkim := got["dkim"]; dkim.Name.ValueString() != "s1._domainkey" || !reflect.DeepEqual(dnsRecordValues(dkim), []string{"k=rsa; p=abc"}) {
+		t.Errorf("unexpected dkim record %+v", dkim)
+	}
+	if cname := got["tracking_cname"]; cname.Name.ValueString() != "email" || !reflect.DeepEqual(dnsRecordValues(cname), []string{"mailgun.org"}) {
+		t.Errorf("unexpected tracking record %+v", cname)
+	}
+	mx := got["mx"]
+	if mx.Name.ValueString() != "@" || mx.Type.ValueString() != "MX" || mx.Priority.ValueInt64() != 10 ||
+		!reflect.DeepEqual(dnsRecordValues(mx), []string{"mxa.mailgun.org", "mxb.mailgun.org"}) {
+		t.Errorf("unexpected mx record %+v", mx)
+	}
+}
+
+func TestDomainDNSRecordsMap_StableDkimKeys(t *testing.T) {
+	sending, receiving := predictDomainRecords("mg.example.com", "us", "s1", false)
+	sending[1].Value = "k=rsa; p=abc"
+	before := domainDNSRecords("mg.example.com", sending, receiving)
+
+	// A second DKIM key showing up must not rename the signing one.
+	sending = append(sending, mtypes.DNSRecord{Name: "s2._domainkey.mg.example.com", RecordType: "TXT", Value: "k=rsa; p=def"})
+	after := domainDNSRecords("mg.example.com", sending, receiving)
+	if !reflect.DeepEqual(before["dkim"], after["dkim"]) {
+		t.Errorf("dkim entry changed: %+v -> %+v", before["dkim"], after["dkim"])
+	}
+	if name := after["dkim_s2"].Name.ValueString(); name != "s2._domainkey" {
+		t.Errorf("dkim_s2 name = %q, want s2._domainkey", name)
+	}
+}
+
+func TestDomainDNSRecordsMap_AutomaticSenderSecurity(t *testing.T) {
+	sending, receiving := predictDomainRecords("mg.example.com", "eu", "", true)
+	sending = append(sending, mtypes.DNSRecord{Name: "_dmarc.mg.example.com", RecordType: "TXT", Value: "v=DMARC1; p=none"})
+
+	got := domainDNSRecords("mg.example.com", sending, receiving)
+	if len(got) != 6 {
+		t.Errorf("got %d records, want 6: %v", len(got), got)
+	}
+	for _, key := range []string{"spf", "dkim_pdk1", "dkim_pdk2", "tracking_cname", "mx", "dmarc"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("missing %s in %v", key, got)
+		}
+	}
+	if name := got["dkim_pdk2"].Name.ValueString(); name != "pdk2._domainkey" {
+		t.Errorf("dkim_pdk2 name = %q, want pdk2._domainkey", name)
+	}
+}
+
+func testDkimValue(t *testing.T, bits int) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "k=rsa; p=" + base64.StdEncoding.EncodeToString(der)
+}
+
+func TestDkimFromRecords(t *testing.T) {
+	sending, _ := predictDomainRecords("mg.example.com", "us", "s1", false)
+	sending[1].Value = testDkimValue(t, 2048)
+
+	sel, size, ok := dkimFromRecords("mg.example.com", sending)
+	if !ok || sel != "s1" || size != 2048 {
+		t.Errorf("got %q, %d, %t; want s1, 2048, true", sel, size, ok)
+	}
+
+	// A selector for another domain's DKIM authority is not ours.
+	sending[1].Name = "s1._domainkey.example.com"
+	if _, _, ok := dkimFromRecords("mg.example.com", sending); ok {
+		t.Error("expected no DKIM record for a foreign name")
+	}
+
+	auto, _ := predictDomainRecords("mg.example.com", "us", "", true)
+	if _, _, ok := dkimFromRecords("mg.example.com", auto); ok {
+		t.Error("automatic sender security CNAMEs must not yield a selector")
+	}
+}
+
+func TestDkimKeySize(t *testing.T) {
+	if size, ok := dkimKeySize(testDkimValue(t, 1024)); !ok || size != 1024 {
+		t.Errorf("size = %d, %t; want 1024", size, ok)
+	}
+	for _, v := range []string{"", "k=rsa;", "k=rsa; p=not-base64!", "v=DKIM1; p=" + base64.StdEncoding.EncodeToString([]byte("junk"))} {
+		if _, ok := dkimKeySize(v); ok {
+			t.Errorf("dkimKeySize(%q) succeeded", v)
+		}
+	}
+}
+
+func TestApplyDomainResponse_Dkim(t *testing.T) {
+	ctx := context.Background()
+	sending, _ := predictDomainRecords("mg.example.com", "us", "tf2", false)
+	sending[1].Value = testDkimValue(t, 2048)
+	resp := &mtypes.GetDomainResponse{
+		Domain:            mtypes.Domain{Name: "mg.example.com"},
+		SendingDNSRecords: sending,
+	}
+
+	// Create without dkim_selector and dkim_key_size, or import.
+	m := domainResourceModel{DkimSelector: types.StringUnknown(), DkimKeySize: types.Int64Null()}
+	if d := applyDomainResponse(ctx, &m, resp, nil); d.HasError() {
+		t.Fatal(d)
+	}
+	if m.DkimSelector.ValueString() != "tf2" || m.DkimKeySize.ValueInt64() != 2048 {
+		t.Errorf("got %s, %s; want tf2, 2048", m.DkimSelector, m.DkimKeySize)
+	}
+
+	// A selector changed outside Terraform is drift.
+	m = domainResourceModel{DkimSelector: types.StringValue("s1"), DkimKeySize: types.Int64Value(1024)}
+	if d := applyDomainResponse(ctx, &m, resp, nil); d.HasError() {
+		t.Fatal(d)
+	}
+	if m.DkimSelector.ValueString() != "tf2" || m.DkimKeySize.ValueInt64() != 2048 {
+		t.Errorf("got %s, %s; want tf2, 2048", m.DkimSelector, m.DkimKeySize)
+	}
+}
+
+func TestKeepActiveDkimSelector(t *testing.T) {
+	s1 := dkimTestKey("s1", true, "valid")
+	s1.DNSRecord.Value = testDkimValue(t, 1024)
+	keys := []mailgunpkg.DkimKey{s1, dkimTestKey("tf2", true, "valid")}
+
+	// s1 still signs next to tf2, which the DKIM record shows.
+	m := domainResourceModel{DkimSelector: types.StringValue("tf2"), DkimKeySize: types.Int64Value(2048)}
+	keepActiveDkimSelector(&m, "s1", keys)
+	if m.DkimSelector.ValueString() != "s1" || m.DkimKeySize.ValueInt64() != 1024 {
+		t.Errorf("got %s, %s; want s1, 1024", m.DkimSelector, m.DkimKeySize)
+	}
+
+	// A deactivated key is drift.
+	keys[0].DNSRecord.Active = false
+	m = domainResourceModel{DkimSelector: types.StringValue("tf2"), DkimKeySize: types.Int64Value(2048)}
+	keepActiveDkimSelector(&m, "s1", keys)
+	if m.DkimSelector.ValueString() != "tf2" {
+		t.Errorf("selector = %s, want tf2", m.DkimSelector)
+	}
+}
+
+func TestDkimAuthorityIsSelf(t *testing.T) {
+	cases := []struct {
+		names    []string
+		self, ok bool
+	}{
+		{[]string{"mg.example.com", "s1._domainkey.mg.example.com"}, true, true},
+		{[]string{"mg.example.com", "s1._domainkey.example.com"}, false, true},
+		{[]string{"pdk1._domainkey.mg.example.com"}, true, true},
+		{[]string{"mg.example.com", "email.mg.example.com"}, false, false},
+	}
+	for _, tc := range cases {
+		self, ok := dkimAuthorityIsSelf("mg.example.com", tc.names)
+		if self != tc.self || ok != tc.ok {
+			t.Errorf("dkimAuthorityIsSelf(%v) = %t, %t; want %t, %t", tc.names, self, ok, tc.self, tc.ok)
+		}
+	}
+}
+
+func TestHasParentDomain(t *testing.T) {
+	names := []string{"example.com", "other.org", "mg.example.net"}
+	for domain, want := range map[string]bool{
+		"mg.example.com":   true,
+		"a.b.example.com":  true,
+		"example.com":      false,
+		"notexample.com":   false,
+		"example.net":      false,
+		"x.mg.example.net": true,
+	} {
+		if got := hasParentDomain(domain, names); got != want {
+			t.Errorf("hasParentDomain(%q) = %t, want %t", domain, got, want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*domainDNSRecordsFunction)(nil)
+
+// NewDomainDNSRecordsFunction is the constructor registered with the
+// framework provider for provider::mailgun::domain_dns_records.
+func NewDomainDNSRecordsFunction() function.Function {
+	return &domainDNSRecordsFunction{}
+}
+
+// domainDNSRecordsFunction predicts the DNS records of a domain before it
+// exists, so DNS providers can be wired up in the same plan that creates the
+// mailgun_domain.
+type domainDNSRecordsFunction struct{}
+
+var predictedSendingRecordAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"record_type": types.StringType,
+	"value":       types.StringType,
+}
+
+var predictedReceivingRecordAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"priority":    types.StringType,
+	"record_type": types.StringType,
+	"value":       types.StringType,
+}
+
+var predictedRecordsAttrTypes = map[string]attr.Type{
+	"sending_records":   types.ListType{ElemType: types.ObjectType{AttrTypes: predictedSendingRecordAttrTypes}},
+	"receiving_records": types.ListType{ElemType: types.ObjectType{AttrTypes: predictedReceivingRecordAttrTypes}},
+}
+
+func (f *domainDNSRecordsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "domain_dns_records"
+}
+
+func (f *domainDNSRecordsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Predict the DNS records of a Mailgun domain",
+		Description: "Returns the sending and receiving DNS records mailgun_domain will report for a domain created " +
+			"with the given settings. Values Mailgun generates on creation, such as the DKIM public key, are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "name", Description: "Domain name."},
+			function.StringParameter{Name: "region", Description: "Region of the domain, us or eu."},
+			function.StringParameter{
+				Name:           "dkim_selector",
+				Description:    "DKIM selector, or null to let Mailgun choose one.",
+				AllowNullValue: true,
+			},
+			function.BoolParameter{
+				Name:        "use_automatic_sender_security",
+				Description: "Whether the domain uses automatic sender security.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: predictedRecordsAttrTypes},
+	}
+}
+
+func (f *domainDNSRecordsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, region string
+	var selector types.String
+	var automatic bool
+	resp.Error = req.Arguments.Get(ctx, &name, &region, &selector, &automatic)
+	if resp.Error != nil {
+		return
+	}
+
+	sending, receiving := predictDomainRecords(name, region, selector.ValueString(), automatic)
+
+	sendingValues := make([]attr.Value, len(sending))
+	for i, r := range sending {
+		sendingValues[i] = types.ObjectValueMust(predictedSendingRecordAttrTypes, map[string]attr.Value{
+			"id":          types.StringValue(sendingRecordID(name, r.Name, automatic)),
+			"name":        stringOrNull(r.Name),
+			"record_type": types.StringValue(r.RecordType),
+			"value":       stringOrNull(r.Value),
+		})
+	}
+	receivingValues := make([]attr.Value, len(receiving))
+	for i, r := range receiving {
+		receivingValues[i] = types.ObjectValueMust(predictedReceivingRecordAttrTypes, map[string]attr.Value{
+			"id":          types.StringValue(r.Value),
+			"priority":    types.StringValue(r.Priority),
+			"record_type": types.StringValue(r.RecordType),
+			"value":       types.StringValue(r.Value),
+		})
+	}
+
+	result := types.ObjectValueMust(predictedRecordsAttrTypes, map[string]attr.Value{
+		"sending_records": types.ListValueMust(
+			types.ObjectType{AttrTypes: predictedSendingRecordAttrTypes}, sendingValues),
+		"receiving_records": types.ListValueMust(
+			types.ObjectType{AttrTypes: predictedReceivingRecordAttrTypes}, receivingValues),
+	})
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,44 @@
+package framework_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Provider functions run without Mailgun access, so only TF_ACC is needed.
+func TestAccMailgunDomainDNSRecordsFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6Providers(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  records = provider::mailgun::domain_dns_records("mg.example.com", "us", "s1", false)
+}
+
+output "spf" {
+  value = local.records.sending_records[0].value
+}
+
+output "dkim_name" {
+  value = local.records.sending_records[1].name
+}
+
+output "mx" {
+  value = local.records.receiving_records[0].value
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("spf", "v=spf1 include:mailgun.org ~all"),
+					resource.TestCheckOutput("dkim_name", "s1._domainkey.mg.example.com"),
+					resource.TestCheckOutput("mx", "mxa.mailgun.org"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runDomainDNSRecords(t *testing.T, args ...attr.Value) types.Object {
+	t.Helper()
+	ctx := context.Background()
+	resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(predictedRecordsAttrTypes))}
+	NewDomainDNSRecordsFunction().Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	if resp.Error != nil {
+		t.Fatalf("domain_dns_records: %s", resp.Error)
+	}
+	return resp.Result.Value().(types.Object)
+}
+
+func predictedRecord(t *testing.T, records types.Object, list string, i int) map[string]attr.Value {
+	t.Helper()
+	elems := records.Attributes()[list].(types.List).Elements()
+	if i >= len(elems) {
+		t.Fatalf("%s has %d records, want index %d", list, len(elems), i)
+	}
+	return elems[i].(types.Object).Attributes()
+}
+
+func TestDomainDNSRecords_Selector(t *testing.T) {
+	records := runDomainDNSRecords(t, types.StringValue("mg.example.com"), types.StringValue("eu"),
+		types.StringValue("s1"), types.BoolValue(false))
+
+	spf := predictedRecord(t, records, "sending_records", 0)
+	if got := spf["value"].(types.String).ValueString(); got != "v=spf1 include:eu.mailgun.org ~all" {
+		t.Errorf("spf value = %q", got)
+	}
+	dkim := predictedRecord(t, records, "sending_records", 1)
+	if got := dkim["name"].(types.String).ValueString(); got != "s1._domainkey.mg.example.com" {
+		t.Errorf("dkim name = %q", got)
+	}
+	if got := dkim["id"].(types.String).ValueString(); got != "_domainkey.mg.example.com" {
+		t.Errorf("dkim id = %q, want the id applyDomainResponse assigns", got)
+	}
+	if !dkim["value"].IsNull() {
+		t.Errorf("dkim public key cannot be predicted and must be null, got %s", dkim["value"])
+	}
+	mx := predictedRecord(t, records, "receiving_records", 1)
+	if got := mx["value"].(types.String).ValueString(); got != "mxb.eu.mailgun.org" {
+		t.Errorf("mx value = %q", got)
+	}
+}
+
+func TestDomainDNSRecords_AutomaticSenderSecurity(t *testing.T) {
+	records := runDomainDNSRecords(t, types.StringValue("mg.example.com"), types.StringValue("us"),
+		types.StringNull(), types.BoolValue(true))
+
+	sending := records.Attributes()["sending_records"].(types.List).Elements()
+	if len(sending) != 4 {
+		t.Fatalf("expected spf, two dkim cnames and tracking cname, got %d records", len(sending))
+	}
+	pdk1 := predictedRecord(t, records, "sending_records", 1)
+	if got := pdk1["id"].(types.String).ValueString(); got != "pdk1._domainkey.mg.example.com" {
+		t.Errorf("dkim cname id = %q", got)
+	}
+	if got := pdk1["record_type"].(types.String).ValueString(); got != "CNAME" {
+		t.Errorf("dkim record type = %q", got)
+	}
+	tracking := predictedRecord(t, records, "sending_records", 3)
+	if got := tracking["value"].(types.String).ValueString(); got != "mailgun.org" {
+		t.Errorf("tracking cname value = %q", got)
+	}
+}
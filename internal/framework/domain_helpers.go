@@ -2,11 +2,12 @@ package framework
 
 import (
 	"context"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mailgun/mailgun-go/v5/mtypes"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
 )
 
 // applyDomainResponse fills the model fields with values from a Mailgun
@@ -22,14 +23,28 @@ func applyDomainResponse(ctx context.Context, m *domainResourceModel, resp *mtyp
 	m.WebScheme = types.StringValue(resp.Domain.WebScheme)
 	m.UseAutomaticSenderSecurity = types.BoolValue(resp.Domain.UseAutomaticSenderSecurity)
 
+	// The selector and key size are derived from the DKIM record so drift
+	// shows up; values that cannot be derived keep what the model holds.
+	if sel, size, ok := dkimFromRecords(resp.Domain.Name, resp.SendingDNSRecords); ok && !resp.Domain.UseAutomaticSenderSecurity {
+		m.DkimSelector = types.StringValue(sel)
+		if size > 0 {
+			m.DkimKeySize = types.Int64Value(size)
+		}
+	}
+	if m.DkimSelector.IsUnknown() {
+		m.DkimSelector = types.StringNull()
+	}
+	if m.DkimKeySize.IsUnknown() {
+		m.DkimKeySize = types.Int64Null()
+	}
+	if m.ForceDkimAuthority.IsUnknown() {
+		m.ForceDkimAuthority = types.BoolNull()
+	}
+
 	sending := make([]sendingRecordModel, len(resp.SendingDNSRecords))
 	for i, r := range resp.SendingDNSRecords {
-		id := r.Name
-		if strings.Contains(r.Name, "._domainkey.") && !resp.Domain.UseAutomaticSenderSecurity {
-			id = "_domainkey." + resp.Domain.Name
-		}
 		sending[i] = sendingRecordModel{
-			ID:         types.StringValue(id),
+			ID:         types.StringValue(sendingRecordID(resp.Domain.Name, r.Name, resp.Domain.UseAutomaticSenderSecurity)),
 			Name:       types.StringValue(r.Name),
 			RecordType: types.StringValue(r.RecordType),
 			Valid:      types.StringValue(r.Valid),
@@ -58,6 +73,13 @@ func applyDomainResponse(ctx context.Context, m *domainResourceModel, resp *mtyp
 		m.ReceivingRecordsSet = receivingSet
 	}
 
+	dnsRecords, d := types.MapValueFrom(ctx, dnsRecordObjectType(),
+		domainDNSRecords(resp.Domain.Name, resp.SendingDNSRecords, resp.ReceivingDNSRecords))
+	diags.Append(d...)
+	if !diags.HasError() {
+		m.DNSRecords = dnsRecords
+	}
+
 	if tracking != nil {
 		m.OpenTracking = types.BoolValue(tracking.Open.Active)
 		m.ClickTracking = types.BoolValue(tracking.Click.Active)
@@ -65,3 +87,21 @@ func applyDomainResponse(ctx context.Context, m *domainResourceModel, resp *mtyp
 
 	return diags
 }
+
+// keepActiveDkimSelector restores selector, the value held before refreshing,
+// when it is still an active key of the domain. Mailgun's DKIM record shows
+// only one of several active keys, so a key activated through
+// mailgun_domain_dkim_key or mailgun_domain_dkim_rotation is not drift as
+// long as the configured one keeps signing.
+func keepActiveDkimSelector(m *domainResourceModel, selector string, keys []mailgunpkg.DkimKey) {
+	for _, k := range keys {
+		if k.Selector != selector || !k.DNSRecord.Active {
+			continue
+		}
+		m.DkimSelector = types.StringValue(selector)
+		if size, ok := dkimKeySize(k.DNSRecord.Value); ok {
+			m.DkimKeySize = types.Int64Value(size)
+		}
+		return
+	}
+}
@@ -5,8 +5,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// domainResourceModel mirrors the mailgun_domain resource state. Field tags
-// must match the schema attribute names.
+// domainResourceModel mirrors the mailgun_domain attributes shared by the
+// resource and the data source. Field tags must match the schema attribute
+// names.
 type domainResourceModel struct {
 	ID                         types.String `tfsdk:"id"`
 	Name                       types.String `tfsdk:"name"`
@@ -24,6 +25,14 @@ type domainResourceModel struct {
 	UseAutomaticSenderSecurity types.Bool   `tfsdk:"use_automatic_sender_security"`
 	ReceivingRecordsSet        types.Set    `tfsdk:"receiving_records_set"`
 	SendingRecordsSet          types.Set    `tfsdk:"sending_records_set"`
+	DNSRecords                 types.Map    `tfsdk:"dns_records"`
+}
+
+// protectedDomainModel is the mailgun_domain resource state: the shared
+// attributes plus the resource-only deletion_protection.
+type protectedDomainModel struct {
+	domainResourceModel
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
 }
 
 // sendingRecordModel mirrors a sending_records_set element.
@@ -44,6 +53,16 @@ type receivingRecordModel struct {
 	Value      types.String `tfsdk:"value"`
 }
 
+// dnsRecordModel mirrors a dns_records element.
+type dnsRecordModel struct {
+	Name     types.String `tfsdk:"name"`
+	FQDN     types.String `tfsdk:"fqdn"`
+	Type     types.String `tfsdk:"type"`
+	Values   types.List   `tfsdk:"values"`
+	Priority types.Int64  `tfsdk:"priority"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+}
+
 // sendingRecordObjectType describes the cty type of a sending_records_set
 // element. It is used to construct types.Set values in the resource code.
 func sendingRecordObjectType() attr.Type {
@@ -75,3 +94,19 @@ func receivingRecordAttrTypes() map[string]attr.Type {
 		"value":       types.StringType,
 	}
 }
+
+// dnsRecordObjectType describes the cty type of a dns_records element.
+func dnsRecordObjectType() attr.Type {
+	return types.ObjectType{AttrTypes: dnsRecordAttrTypes()}
+}
+
+func dnsRecordAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":     types.StringType,
+		"fqdn":     types.StringType,
+		"type":     types.StringType,
+		"values":   types.ListType{ElemType: types.StringType},
+		"priority": types.Int64Type,
+		"ttl":      types.Int64Type,
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
 )
@@ -71,7 +72,10 @@ func (r *domainResource) UpgradeState(_ context.Context) map[int64]resource.Stat
 				if resp.Diagnostics.HasError() {
 					return
 				}
-				upgraded := prior.toV1()
+				upgraded := protectedDomainModel{
+					domainResourceModel: prior.toV1(),
+					DeletionProtection:  types.BoolValue(false),
+				}
 				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
 			},
 		},
@@ -88,4 +92,6 @@ func (r *domainResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedDkimAuthorityKey, []byte("true"))...)
 }
@@ -8,7 +8,9 @@ import (
 
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/mailgun/mailgun-go/v5/mtypes"
 )
 
@@ -38,12 +40,107 @@ func TestAccMailgunDomain_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("mailgun_domain.foobar", "use_automatic_sender_security", "true"),
 					testAccCheckAnyAttrMatches(
 						"mailgun_domain.foobar", "sending_records_set", "name", re),
+					resource.TestCheckResourceAttr("mailgun_domain.foobar", "dns_records.spf.name", "@"),
+					resource.TestCheckResourceAttr("mailgun_domain.foobar", "dns_records.spf.ttl", "3600"),
+					resource.TestCheckResourceAttr("mailgun_domain.foobar", "dns_records.mx.priority", "10"),
+					resource.TestCheckResourceAttr("mailgun_domain.foobar", "dns_records.mx.values.#", "2"),
+					resource.TestCheckResourceAttr("mailgun_domain.foobar", "dns_records.tracking_cname.name", "email"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccMailgunDomain_UpdateInPlace(t *testing.T) {
+	resourceName := "mailgun_domain.foobar"
+	id, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraform.%s.com", id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckMailgunDomainConfig(domain),
+			},
+			{
+				Config: testAccMailgunDomainUpdatedConfig(domain),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "spam_action", "tag"),
+					resource.TestCheckResourceAttr(resourceName, "wildcard", "false"),
+					resource.TestCheckResourceAttr(resourceName, "force_dkim_authority", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_automatic_sender_security", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailgunDomain_DkimSelector(t *testing.T) {
+	resourceName := "mailgun_domain.foobar"
+	id, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraform.%s.com", id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunDomainSelectorConfig(domain, "tf1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dkim_selector", "tf1"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_key_size", "2048"),
+				),
+			},
+			{
+				Config: testAccMailgunDomainSelectorConfig(domain, "tf2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dkim_selector", "tf2"),
+					resource.TestCheckResourceAttr(resourceName, "dns_records.dkim.name", "tf2._domainkey"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailgunDomain_DeletionProtection(t *testing.T) {
+	id, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraform.%s.com", id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunDomainProtectedConfig(domain, true),
+				Check:  resource.TestCheckResourceAttr("mailgun_domain.foobar", "deletion_protection", "true"),
+			},
+			{
+				Config:      testAccMailgunDomainProtectedConfig(domain, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Domain is protected from deletion`),
+			},
+			{
+				Config: testAccMailgunDomainProtectedConfig(domain, false),
+				Check:  resource.TestCheckResourceAttr("mailgun_domain.foobar", "deletion_protection", "false"),
+			},
+		},
+	})
+}
+
 func TestAccMailgunDomain_Import(t *testing.T) {
 	resourceName := "mailgun_domain.foobar"
 	id, _ := uuid.GenerateUUID()
@@ -58,10 +155,82 @@ func TestAccMailgunDomain_Import(t *testing.T) {
 				Config: testAccCheckMailgunDomainConfig(domain),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"dkim_key_size", "force_dkim_authority"},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "us:" + domain,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMailgunDomain_ImportPlan(t *testing.T) {
+	resourceName := "mailgun_domain.foobar"
+	id, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraform.%s.com", id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunDomainDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunDomainSelectorConfig(domain, "tf1"),
+			},
+			{
+				// An import block followed by plan must not propose changes.
+				Config:          testAccMailgunDomainSelectorConfig(domain, "tf1"),
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ImportStateId:   "us:" + domain,
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+// A root domain publishes its DKIM record under its own name, which must
+// not conflict with the default force_dkim_authority = false after import.
+func TestAccMailgunDomain_ImportPlanDkimAuthority(t *testing.T) {
+	resourceName := "mailgun_domain.foobar"
+	id, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraform.%s.com", id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunDomainDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunDomainDkimAuthorityConfig(domain, false),
+			},
+			{
+				Config:          testAccMailgunDomainDkimAuthorityConfig(domain, false),
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ImportStateId:   "us:" + domain,
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
 			},
 		},
 	})
@@ -150,3 +319,46 @@ resource "mailgun_domain" "foobar" {
 	use_automatic_sender_security = true
 }`
 }
+
+func testAccMailgunDomainUpdatedConfig(domain string) string {
+	return `
+resource "mailgun_domain" "foobar" {
+    name = "` + domain + `"
+	spam_action = "tag"
+	region = "us"
+    wildcard = false
+	force_dkim_authority = false
+	open_tracking = true
+	click_tracking = true
+	web_scheme = "https"
+	use_automatic_sender_security = false
+}`
+}
+
+func testAccMailgunDomainSelectorConfig(domain, selector string) string {
+	return fmt.Sprintf(`
+resource "mailgun_domain" "foobar" {
+  name          = "%s"
+  region        = "us"
+  dkim_selector = "%s"
+  dkim_key_size = 2048
+}`, domain, selector)
+}
+
+func testAccMailgunDomainProtectedConfig(domain string, protected bool) string {
+	return fmt.Sprintf(`
+resource "mailgun_domain" "foobar" {
+  name                = "%s"
+  region              = "us"
+  deletion_protection = %t
+}`, domain, protected)
+}
+
+func testAccMailgunDomainDkimAuthorityConfig(domain string, self bool) string {
+	return fmt.Sprintf(`
+resource "mailgun_domain" "foobar" {
+  name                 = "%s"
+  region               = "us"
+  force_dkim_authority = %t
+}`, domain, self)
+}
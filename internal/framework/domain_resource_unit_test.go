@@ -0,0 +1,35 @@
+package framework
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDomainDelete_DeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	sch := domainResourceSchema()
+	state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}
+	if d := state.SetAttribute(ctx, path.Root("id"), "mg.example.com"); d.HasError() {
+		t.Fatal(d)
+	}
+	if d := state.SetAttribute(ctx, path.Root("deletion_protection"), true); d.HasError() {
+		t.Fatal(d)
+	}
+
+	// The resource has no client configured: reaching the API would panic.
+	resp := &resource.DeleteResponse{State: state}
+	(&domainResource{}).Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected Delete to fail for a protected domain")
+	}
+	if detail := resp.Diagnostics[0].Detail(); !strings.Contains(detail, `"mg.example.com"`) || !strings.Contains(detail, "deletion_protection = false") {
+		t.Errorf("unexpected detail: %s", detail)
+	}
+}
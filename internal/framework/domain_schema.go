@@ -1,14 +1,21 @@
 package framework
 
 import (
+	"context"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // domainResourceSchema returns the framework schema for mailgun_domain.
@@ -42,9 +49,6 @@ func domainResourceSchema() schema.Schema {
 				Optional: true,
 				Computed: true,
 				Default:  stringdefault.StaticString("disabled"),
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"smtp_login": schema.StringAttribute{
 				Computed: true,
@@ -64,20 +68,19 @@ func domainResourceSchema() schema.Schema {
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(false),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
 			},
 			"dkim_selector": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"force_dkim_authority": schema.BoolAttribute{
 				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"open_tracking": schema.BoolAttribute{
@@ -97,7 +100,9 @@ func domainResourceSchema() schema.Schema {
 			},
 			"dkim_key_size": schema.Int64Attribute{
 				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 					int64planmodifier.RequiresReplace(),
 				},
 			},
@@ -105,12 +110,38 @@ func domainResourceSchema() schema.Schema {
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(false),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "When `true`, destroying or replacing the domain fails until it is set back to `false`.",
 			},
 			"sending_records_set":   sendingRecordsSetAttribute(),
 			"receiving_records_set": receivingRecordsSetAttribute(),
+			"dns_records":           dnsRecordsAttribute(),
+		},
+	}
+}
+
+func dnsRecordsAttribute() schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		Computed: true,
+		MarkdownDescription: "The records to publish, one per name and type, keyed by purpose (`spf`, `dkim`, `tracking_cname`, `mx`, ...). " +
+			"DKIM records other than the signing one are keyed by selector, e.g. `dkim_pdk1`.",
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.UseStateForUnknown(),
+			recordsChangeWith(dkimRecordAttributes...),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name":     schema.StringAttribute{Computed: true},
+				"fqdn":     schema.StringAttribute{Computed: true},
+				"type":     schema.StringAttribute{Computed: true},
+				"values":   schema.ListAttribute{Computed: true, ElementType: types.StringType},
+				"priority": schema.Int64Attribute{Computed: true},
+				"ttl":      schema.Int64Attribute{Computed: true},
+			},
 		},
 	}
 }
@@ -120,6 +151,7 @@ func sendingRecordsSetAttribute() schema.SetNestedAttribute {
 		Computed: true,
 		PlanModifiers: []planmodifier.Set{
 			setplanmodifier.UseStateForUnknown(),
+			recordsChangeWith(dkimRecordAttributes...),
 		},
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
@@ -150,3 +182,79 @@ func receivingRecordsSetAttribute() schema.SetNestedAttribute {
 		},
 	}
 }
+
+// importedDkimAuthorityKey marks, in private state, a domain imported but not
+// yet read. Mailgun does not return force_dkim_authority, so the first Read
+// derives it from the DKIM record and clears the marker.
+const importedDkimAuthorityKey = "imported_dkim_authority"
+
+// dkimRecordAttributes are the domain arguments that change the DKIM records
+// Mailgun asks for when updated in place.
+var dkimRecordAttributes = []string{"use_automatic_sender_security", "dkim_selector", "force_dkim_authority"}
+
+// recordsChangeWithModifier marks a computed record collection unknown when
+// one of the listed arguments changes in place, overriding
+// UseStateForUnknown so the plan does not promise the old records.
+type recordsChangeWithModifier struct {
+	attributes []string
+}
+
+var (
+	_ planmodifier.Set = recordsChangeWithModifier{}
+	_ planmodifier.Map = recordsChangeWithModifier{}
+)
+
+func recordsChangeWith(attributes ...string) recordsChangeWithModifier {
+	return recordsChangeWithModifier{attributes: attributes}
+}
+
+func (m recordsChangeWithModifier) Description(_ context.Context) string {
+	return "records are recomputed when " + strings.Join(m.attributes, " or ") + " changes"
+}
+
+func (m recordsChangeWithModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m recordsChangeWithModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if m.changed(req.Plan, req.State) {
+		resp.PlanValue = types.SetUnknown(req.PlanValue.ElementType(ctx))
+	}
+}
+
+func (m recordsChangeWithModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	if m.changed(req.Plan, req.State) {
+		resp.PlanValue = types.MapUnknown(req.PlanValue.ElementType(ctx))
+	}
+}
+
+func (m recordsChangeWithModifier) changed(plan tfsdk.Plan, state tfsdk.State) bool {
+	if state.Raw.IsNull() || plan.Raw.IsNull() {
+		return false
+	}
+	for _, name := range m.attributes {
+		p := tftypes.NewAttributePath().WithAttributeName(name)
+		planned, _, err := tftypes.WalkAttributePath(plan.Raw, p)
+		if err != nil {
+			continue
+		}
+		prior, _, err := tftypes.WalkAttributePath(state.Raw, p)
+		if err != nil {
+			continue
+		}
+		pv, ok1 := planned.(tftypes.Value)
+		sv, ok2 := prior.(tftypes.Value)
+		if !ok1 || !ok2 {
+			continue
+		}
+		// An unset computed argument is unknown in any update plan; that
+		// alone does not change the records.
+		if !pv.IsKnown() && sv.IsNull() {
+			continue
+		}
+		if !pv.Equal(sv) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRecordsChangeWith(t *testing.T) {
+	ctx := context.Background()
+	sch := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"use_automatic_sender_security": schema.BoolAttribute{Optional: true},
+			"spam_action":                   schema.StringAttribute{Optional: true},
+			"dkim_selector":                 schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+	objType := sch.Type().TerraformType(ctx)
+	raw := func(auto bool, spam string, selector any) tftypes.Value {
+		return tftypes.NewValue(objType, map[string]tftypes.Value{
+			"use_automatic_sender_security": tftypes.NewValue(tftypes.Bool, auto),
+			"spam_action":                   tftypes.NewValue(tftypes.String, spam),
+			"dkim_selector":                 tftypes.NewValue(tftypes.String, selector),
+		})
+	}
+	prior := types.MapValueMust(types.StringType, nil)
+
+	cases := []struct {
+		name        string
+		plan, state tftypes.Value
+		wantUnknown bool
+	}{
+		{"unrelated change", raw(false, "tag", "s1"), raw(false, "disabled", "s1"), false},
+		{"sender security change", raw(true, "disabled", "s1"), raw(false, "disabled", "s1"), true},
+		{"selector change", raw(false, "disabled", "s2"), raw(false, "disabled", "s1"), true},
+		{"unset selector", raw(true, "tag", tftypes.UnknownValue), raw(true, "disabled", nil), false},
+		{"create", raw(true, "disabled", tftypes.UnknownValue), tftypes.NewValue(objType, nil), false},
+	}
+	for _, tc := range cases {
+		req := planmodifier.MapRequest{
+			Plan:      tfsdk.Plan{Schema: sch, Raw: tc.plan},
+			State:     tfsdk.State{Schema: sch, Raw: tc.state},
+			PlanValue: prior,
+		}
+		resp := &planmodifier.MapResponse{PlanValue: prior}
+		recordsChangeWith(dkimRecordAttributes...).PlanModifyMap(ctx, req, resp)
+		if got := resp.PlanValue.IsUnknown(); got != tc.wantUnknown {
+			t.Errorf("%s: unknown = %t, want %t", tc.name, got, tc.wantUnknown)
+		}
+	}
+}
+
+func TestDomainUpdates(t *testing.T) {
+	state := domainResourceModel{
+		SpamAction:                 types.StringValue("disabled"),
+		Wildcard:                   types.BoolValue(true),
+		WebScheme:                  types.StringValue("http"),
+		UseAutomaticSenderSecurity: types.BoolValue(false),
+	}
+	plan := state
+
+	if _, changed := domainUpdateOptions(&plan, &state); changed {
+		t.Error("no UpdateDomain call expected without changes")
+	}
+	if _, changed := domainSettingsUpdate(&plan, &state); changed {
+		t.Error("no settings update expected without changes")
+	}
+
+	plan.SpamAction = types.StringValue("tag")
+	plan.Wildcard = types.BoolValue(false)
+	plan.UseAutomaticSenderSecurity = types.BoolValue(true)
+
+	opts, changed := domainUpdateOptions(&plan, &state)
+	if !changed || opts.WebScheme != "" || opts.UseAutomaticSenderSecurity == nil || !*opts.UseAutomaticSenderSecurity {
+		t.Errorf("unexpected UpdateDomain options %+v", opts)
+	}
+	settings, changed := domainSettingsUpdate(&plan, &state)
+	if !changed || settings.SpamAction != "tag" || settings.Wildcard == nil || *settings.Wildcard {
+		t.Errorf("unexpected settings %+v", settings)
+	}
+}
@@ -50,6 +50,7 @@ func (v domainResourceModelV0) toV1() domainResourceModel {
 		UseAutomaticSenderSecurity: v.UseAutomaticSenderSecurity,
 		ReceivingRecordsSet:        v.ReceivingRecordsSet,
 		SendingRecordsSet:          v.SendingRecordsSet,
+		DNSRecords:                 types.MapNull(dnsRecordObjectType()),
 	}
 }
 
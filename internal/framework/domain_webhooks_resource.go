@@ -0,0 +1,255 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ resource.Resource                = (*domainWebhooksResource)(nil)
+	_ resource.ResourceWithImportState = (*domainWebhooksResource)(nil)
+	_ resource.ResourceWithConfigure   = (*domainWebhooksResource)(nil)
+)
+
+// NewDomainWebhooksResource is the constructor registered with the framework
+// provider for mailgun_domain_webhooks.
+func NewDomainWebhooksResource() resource.Resource {
+	return &domainWebhooksResource{}
+}
+
+// domainWebhooksResource manages every webhook kind of a domain at once. It
+// is authoritative: kinds present in Mailgun but absent from configuration
+// are deleted on apply.
+type domainWebhooksResource struct {
+	cfg *mailgunpkg.Config
+}
+
+type domainWebhooksResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Region   types.String `tfsdk:"region"`
+	Domain   types.String `tfsdk:"domain"`
+	Webhooks types.Map    `tfsdk:"webhooks"`
+}
+
+func (r *domainWebhooksResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_webhooks"
+}
+
+func (r *domainWebhooksResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("us"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"webhooks": schema.MapAttribute{
+				Required:            true,
+				ElementType:         types.SetType{ElemType: types.StringType},
+				MarkdownDescription: "Webhook URLs keyed by kind. Kinds: " + webhookKindsDescription() + ".",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(allowedWebhookKinds...)),
+					mapvalidator.ValueSetsAre(
+						setvalidator.SizeBetween(1, maxWebhookURLs),
+						setvalidator.ValueStringsAre(webhookURL()),
+						uniqueWebhookURLs(),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *domainWebhooksResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+// ImportState accepts "region:domain" or a bare "domain" (region defaults to
+// "us").
+func (r *domainWebhooksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	region, domain := "us", req.ID
+	if parts := strings.SplitN(req.ID, ":", 2); len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		region, domain = parts[0], parts[1]
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s:%s", region, domain))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+}
+
+func (r *domainWebhooksResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan domainWebhooksResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", plan.Region.ValueString(), plan.Domain.ValueString()))
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *domainWebhooksResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state domainWebhooksResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.cfg.GetClient(state.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Mailgun client error", err.Error())
+		return
+	}
+
+	hooks, err := client.ListWebhooks(ctx, state.Domain.ValueString())
+	if err != nil {
+		if mailgunpkg.IsNotFound(err) {
+			log.Printf("[WARN] Mailgun domain %s not found, removing webhooks from state", state.Domain.ValueString())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read webhooks", err.Error())
+		return
+	}
+
+	webhooks, d := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, managedWebhooks(hooks))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Webhooks = webhooks
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *domainWebhooksResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan domainWebhooksResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *domainWebhooksResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state domainWebhooksResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.cfg.GetClient(state.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Mailgun client error", err.Error())
+		return
+	}
+
+	domain := state.Domain.ValueString()
+	for kind := range state.Webhooks.Elements() {
+		if err := client.DeleteWebhook(ctx, domain, kind); err != nil && !mailgunpkg.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to delete webhook",
+				fmt.Sprintf("deleting %s webhook on %s: %s", kind, domain, err))
+			return
+		}
+	}
+	log.Printf("[INFO] Deleted webhooks of domain: %s", domain)
+}
+
+// apply reconciles Mailgun with the planned webhooks map and refreshes the
+// model from the API afterwards.
+func (r *domainWebhooksResource) apply(ctx context.Context, m *domainWebhooksResourceModel, diags *diag.Diagnostics) {
+	client, err := r.cfg.GetClient(m.Region.ValueString())
+	if err != nil {
+		diags.AddError("Mailgun client error", err.Error())
+		return
+	}
+
+	var desired map[string][]string
+	diags.Append(m.Webhooks.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	domain := m.Domain.ValueString()
+	current, err := client.ListWebhooks(ctx, domain)
+	if err != nil {
+		diags.AddError("Failed to read webhooks", err.Error())
+		return
+	}
+
+	for _, op := range planWebhookSync(managedWebhooks(current), desired) {
+		log.Printf("[INFO] Webhook %s %s on %s", op.action, op.kind, domain)
+		switch op.action {
+		case webhookCreate:
+			err = client.CreateWebhook(ctx, domain, op.kind, op.urls)
+		case webhookUpdate:
+			err = client.UpdateWebhook(ctx, domain, op.kind, op.urls)
+		case webhookDelete:
+			err = client.DeleteWebhook(ctx, domain, op.kind)
+		}
+		if err != nil {
+			diags.AddError("Failed to sync webhooks",
+				fmt.Sprintf("%s %s webhook on %s: %s", op.action, op.kind, domain, err))
+			return
+		}
+	}
+
+	refreshed, err := client.ListWebhooks(ctx, domain)
+	if err != nil {
+		diags.AddError("Failed to refresh webhooks", err.Error())
+		return
+	}
+	webhooks, d := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, managedWebhooks(refreshed))
+	diags.Append(d...)
+	if !diags.HasError() {
+		m.Webhooks = webhooks
+	}
+}
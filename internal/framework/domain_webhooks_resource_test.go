@@ -0,0 +1,88 @@
+package framework_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccMailgunDomainWebhooks_Basic(t *testing.T) {
+	uuid, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraformdwh.%s.com", uuid)
+	resourceName := "mailgun_domain_webhooks.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunDomainWebhooksDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunDomainWebhooksConfig(domain, `
+    delivered = ["https://hoge.com"]
+    opened    = ["https://hoge.com", "https://example.com/hook"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "us:"+domain),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.opened.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client, err := mailgunClientFromAttrs(map[string]string{"region": "us"})
+					if err != nil {
+						t.Fatalf("get client: %s", err)
+					}
+					if err := client.CreateWebhook(context.Background(), domain, "clicked", []string{"https://manual.example.com"}); err != nil {
+						t.Fatalf("create webhook out of band: %s", err)
+					}
+				},
+				Config: testAccMailgunDomainWebhooksConfig(domain, `
+    delivered = ["https://example.com/hook"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "webhooks.%", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "webhooks.delivered.*", "https://example.com/hook"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     domain,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMailgunDomainWebhooksDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mailgun_domain_webhooks" {
+			continue
+		}
+		client, _ := mailgunClientFromAttrs(rs.Primary.Attributes)
+		hooks, err := client.ListWebhooks(context.Background(), rs.Primary.Attributes["domain"])
+		if err == nil && len(hooks) > 0 {
+			return fmt.Errorf("Webhooks still exist: %#v", hooks)
+		}
+	}
+	return nil
+}
+
+func testAccMailgunDomainWebhooksConfig(domain, webhooks string) string {
+	return `
+resource "mailgun_domain" "foobar" {
+    name = "` + domain + `"
+	spam_action = "disabled"
+	region = "us"
+    wildcard = true
+}
+
+resource "mailgun_domain_webhooks" "foobar" {
+  domain = mailgun_domain.foobar.id
+  webhooks = {` + webhooks + `
+  }
+}`
+}
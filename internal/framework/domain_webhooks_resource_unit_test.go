@@ -0,0 +1,54 @@
+package framework
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanWebhookSync(t *testing.T) {
+	current := map[string][]string{
+		"delivered": {"https://a.example.com"},
+		"opened":    {"https://b.example.com", "https://c.example.com"},
+		"clicked":   {"https://manual.example.com"},
+	}
+	desired := map[string][]string{
+		"delivered":      {"https://a.example.com"},
+		"opened":         {"https://c.example.com"},
+		"permanent_fail": {"https://d.example.com"},
+	}
+
+	got := planWebhookSync(current, desired)
+	want := []webhookOp{
+		{action: webhookDelete, kind: "clicked"},
+		{action: webhookUpdate, kind: "opened", urls: []string{"https://c.example.com"}},
+		{action: webhookCreate, kind: "permanent_fail", urls: []string{"https://d.example.com"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("planWebhookSync() =\n%#v\nwant\n%#v", got, want)
+	}
+
+	if ops := planWebhookSync(desired, desired); len(ops) != 0 {
+		t.Errorf("in-sync webhooks should need no calls, got %#v", ops)
+	}
+}
+
+func TestSameURLSetIgnoresOrder(t *testing.T) {
+	if !sameURLSet([]string{"https://b", "https://a"}, []string{"https://a", "https://b"}) {
+		t.Error("order must not matter")
+	}
+	if sameURLSet([]string{"https://a"}, []string{"https://a", "https://b"}) {
+		t.Error("different sets reported equal")
+	}
+}
+
+func TestManagedWebhooksDropsUnknownKinds(t *testing.T) {
+	got := managedWebhooks(map[string][]string{
+		"delivered": {"https://a.example.com"},
+		"legacy":    {"https://b.example.com"},
+		"opened":    {},
+	})
+	want := map[string][]string{"delivered": {"https://a.example.com"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("managedWebhooks() = %#v, want %#v", got, want)
+	}
+}
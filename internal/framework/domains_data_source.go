@@ -0,0 +1,234 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ datasource.DataSource              = (*domainsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*domainsDataSource)(nil)
+)
+
+// NewDomainsDataSource is the constructor registered with the framework
+// provider for data "mailgun_domains".
+func NewDomainsDataSource() datasource.DataSource {
+	return &domainsDataSource{}
+}
+
+type domainsDataSource struct {
+	cfg *mailgunpkg.Config
+}
+
+type domainsDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Region    types.String `tfsdk:"region"`
+	State     types.String `tfsdk:"state"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Wildcard  types.Bool   `tfsdk:"wildcard"`
+	Names     types.List   `tfsdk:"names"`
+	Domains   types.List   `tfsdk:"domains"`
+}
+
+// domainSummaryModel mirrors a domains element.
+type domainSummaryModel struct {
+	Name       types.String `tfsdk:"name"`
+	State      types.String `tfsdk:"state"`
+	Type       types.String `tfsdk:"type"`
+	IsDisabled types.Bool   `tfsdk:"is_disabled"`
+	Wildcard   types.Bool   `tfsdk:"wildcard"`
+	SpamAction types.String `tfsdk:"spam_action"`
+	SmtpLogin  types.String `tfsdk:"smtp_login"`
+	WebScheme  types.String `tfsdk:"web_scheme"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+func domainSummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"state":       types.StringType,
+		"type":        types.StringType,
+		"is_disabled": types.BoolType,
+		"wildcard":    types.BoolType,
+		"spam_action": types.StringType,
+		"smtp_login":  types.StringType,
+		"web_scheme":  types.StringType,
+		"created_at":  types.StringType,
+	}
+}
+
+var domainStates = []string{"active", "unverified", "disabled"}
+
+func (d *domainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func (d *domainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Attributes: map[string]dsschema.Attribute{
+			"id":     dsschema.StringAttribute{Computed: true},
+			"region": dsschema.StringAttribute{Optional: true, Computed: true},
+			"state": dsschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(domainStates...),
+				},
+			},
+			"name_regex": dsschema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{validRegex()},
+			},
+			"wildcard": dsschema.BoolAttribute{Optional: true},
+			"names": dsschema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"domains": dsschema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"name":        dsschema.StringAttribute{Computed: true},
+						"state":       dsschema.StringAttribute{Computed: true},
+						"type":        dsschema.StringAttribute{Computed: true},
+						"is_disabled": dsschema.BoolAttribute{Computed: true},
+						"wildcard":    dsschema.BoolAttribute{Computed: true},
+						"spam_action": dsschema.StringAttribute{Computed: true},
+						"smtp_login":  dsschema.StringAttribute{Computed: true},
+						"web_scheme":  dsschema.StringAttribute{Computed: true},
+						"created_at":  dsschema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *domainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	d.cfg = cfg
+}
+
+func (d *domainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data domainsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := domainFilter{state: data.State.ValueString()}
+	if v := data.NameRegex.ValueString(); v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+		filter.name = re
+	}
+	if !data.Wildcard.IsNull() {
+		v := data.Wildcard.ValueBool()
+		filter.wildcard = &v
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = "us"
+	}
+	domains, err := d.cfg.ListDomains(ctx, region)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list domains", err.Error())
+		return
+	}
+
+	names := []string{}
+	summaries := []domainSummaryModel{}
+	for _, dom := range domains {
+		if !filter.matches(dom) {
+			continue
+		}
+		names = append(names, dom.Name)
+		summaries = append(summaries, domainSummaryFromAPI(dom))
+	}
+
+	nameList, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	domainList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: domainSummaryAttrTypes()}, summaries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(region)
+	data.Region = types.StringValue(region)
+	data.Names = nameList
+	data.Domains = domainList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// domainFilter holds the optional mailgun_domains filters. Zero values match
+// every domain.
+type domainFilter struct {
+	state    string
+	name     *regexp.Regexp
+	wildcard *bool
+}
+
+func (f domainFilter) matches(d mtypes.Domain) bool {
+	if f.state != "" && domainState(d) != f.state {
+		return false
+	}
+	if f.name != nil && !f.name.MatchString(d.Name) {
+		return false
+	}
+	if f.wildcard != nil && d.Wildcard != *f.wildcard {
+		return false
+	}
+	return true
+}
+
+// domainState folds is_disabled into the reported state: Mailgun can flag a
+// domain as disabled while its verification state still reads "active".
+func domainState(d mtypes.Domain) string {
+	if d.IsDisabled {
+		return "disabled"
+	}
+	return d.State
+}
+
+func domainSummaryFromAPI(d mtypes.Domain) domainSummaryModel {
+	createdAt := types.StringNull()
+	if !d.CreatedAt.IsZero() {
+		createdAt = types.StringValue(time.Time(d.CreatedAt).UTC().Format(time.RFC3339))
+	}
+	return domainSummaryModel{
+		Name:       types.StringValue(d.Name),
+		State:      types.StringValue(domainState(d)),
+		Type:       stringOrNull(d.Type),
+		IsDisabled: types.BoolValue(d.IsDisabled),
+		Wildcard:   types.BoolValue(d.Wildcard),
+		SpamAction: types.StringValue(string(d.SpamAction)),
+		SmtpLogin:  stringOrNull(d.SMTPLogin),
+		WebScheme:  stringOrNull(d.WebScheme),
+		CreatedAt:  createdAt,
+	}
+}
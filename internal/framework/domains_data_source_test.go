@@ -0,0 +1,47 @@
+package framework_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMailgunDomainsDataSource_Basic(t *testing.T) {
+	id, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraform.%s.com", id)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunDomainsDataSourceConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mailgun_domains.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.mailgun_domains.test", "names.0", domain),
+					resource.TestCheckResourceAttr("data.mailgun_domains.test", "domains.0.name", domain),
+					resource.TestCheckResourceAttr("data.mailgun_domains.test", "domains.0.state", "unverified"),
+					resource.TestCheckResourceAttr("data.mailgun_domains.test", "domains.0.wildcard", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMailgunDomainsDataSourceConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "mailgun_domain" "foobar" {
+	name = "%s"
+	spam_action = "disabled"
+	wildcard = false
+}
+
+data "mailgun_domains" "test" {
+	state      = "unverified"
+	name_regex = "^${replace(mailgun_domain.foobar.name, ".", "\\.")}$"
+	wildcard   = false
+}
+`, domain)
+}
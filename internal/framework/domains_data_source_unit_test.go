@@ -0,0 +1,59 @@
+package framework
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+)
+
+func TestDomainFilter(t *testing.T) {
+	active := mtypes.Domain{Name: "mail.example.com", State: "active", Wildcard: true}
+	unverified := mtypes.Domain{Name: "new.example.org", State: "unverified"}
+	disabled := mtypes.Domain{Name: "old.example.com", State: "active", IsDisabled: true}
+
+	yes, no := true, false
+	cases := []struct {
+		name   string
+		filter domainFilter
+		domain mtypes.Domain
+		want   bool
+	}{
+		{"empty filter", domainFilter{}, unverified, true},
+		{"state match", domainFilter{state: "active"}, active, true},
+		{"state mismatch", domainFilter{state: "active"}, unverified, false},
+		{"disabled flag wins", domainFilter{state: "disabled"}, disabled, true},
+		{"disabled not active", domainFilter{state: "active"}, disabled, false},
+		{"regex match", domainFilter{name: regexp.MustCompile(`\.com$`)}, active, true},
+		{"regex mismatch", domainFilter{name: regexp.MustCompile(`\.com$`)}, unverified, false},
+		{"wildcard true", domainFilter{wildcard: &yes}, active, true},
+		{"wildcard false", domainFilter{wildcard: &no}, active, false},
+	}
+	for _, tc := range cases {
+		if got := tc.filter.matches(tc.domain); got != tc.want {
+			t.Errorf("%s: matches = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestValidRegex(t *testing.T) {
+	ctx := context.Background()
+	validate := func(v types.String) *validator.StringResponse {
+		resp := &validator.StringResponse{}
+		validRegex().ValidateString(ctx, validator.StringRequest{Path: path.Root("name_regex"), ConfigValue: v}, resp)
+		return resp
+	}
+
+	for _, v := range []types.String{types.StringValue(`^mail\..*\.com$`), types.StringNull(), types.StringUnknown()} {
+		if resp := validate(v); resp.Diagnostics.HasError() {
+			t.Errorf("%s rejected: %v", v, resp.Diagnostics)
+		}
+	}
+	if resp := validate(types.StringValue(`(unclosed`)); !resp.Diagnostics.HasError() {
+		t.Error("an invalid regular expression should be rejected")
+	}
+}
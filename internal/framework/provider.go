@@ -5,9 +5,13 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -19,7 +23,10 @@ import (
 )
 
 // Ensure the implementation satisfies the expected interfaces.
-var _ provider.Provider = (*mailgunProvider)(nil)
+var (
+	_ provider.Provider              = (*mailgunProvider)(nil)
+	_ provider.ProviderWithFunctions = (*mailgunProvider)(nil)
+)
 
 // New returns a constructor for the framework provider. The constructor form
 // is required by providerserver.NewProtocol6.
@@ -39,7 +46,8 @@ func NewProviderServer() (tfprotov6.ProviderServer, error) {
 }
 
 type providerModel struct {
-	APIKey types.String `tfsdk:"api_key"`
+	APIKey      types.String `tfsdk:"api_key"`
+	PageTimeout types.String `tfsdk:"page_timeout"`
 }
 
 func (p *mailgunProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -53,6 +61,9 @@ func (p *mailgunProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:  true,
 				Sensitive: true,
 			},
+			"page_timeout": schema.StringAttribute{
+				Optional: true,
+			},
 		},
 	}
 }
@@ -70,6 +81,15 @@ func (p *mailgunProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 
 	cfg := &mailgun.Config{APIKey: apiKey}
+	if v := data.PageTimeout.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("page_timeout"), "Invalid page_timeout",
+				fmt.Sprintf("expected a positive duration such as \"30s\" or \"2m\", got %q", v))
+			return
+		}
+		cfg.PageTimeout = d
+	}
 	resp.DataSourceData = cfg
 	resp.ResourceData = cfg
 }
@@ -80,6 +100,11 @@ func (p *mailgunProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewRouteResource,
 		NewCredentialResource,
 		NewWebhookResource,
+		NewDomainWebhooksResource,
+		NewAccountWebhookResource,
+		NewWebhookSigningKeyResource,
+		NewDomainDkimKeyResource,
+		NewDomainDkimRotationResource,
 		NewAPIKeyResource,
 	}
 }
@@ -87,5 +112,16 @@ func (p *mailgunProvider) Resources(_ context.Context) []func() resource.Resourc
 func (p *mailgunProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewDomainDataSource,
+		NewAPIKeysDataSource,
+		NewDomainsDataSource,
+		NewRouteDataSource,
+		NewRoutesDataSource,
+		NewWebhooksDataSource,
+		NewWebhookSigningKeyDataSource,
+		NewDomainCredentialsDataSource,
 	}
 }
+
+func (p *mailgunProvider) Functions(_ context.Context) []func() function.Function {
+	return append(routeFunctions(), NewDomainDNSRecordsFunction)
+}
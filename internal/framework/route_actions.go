@@ -0,0 +1,107 @@
+package framework
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// Route actions follow a smaller grammar than expressions:
+//
+//	action = "forward" "(" string ")"
+//	       | "store" "(" [ "notify" "=" string ] ")"
+//	       | "stop" "(" ")"
+
+// routeAction is a single parsed route action.
+type routeAction struct {
+	Func   string // forward, store or stop
+	Target string // forward destination
+	Notify string // store notify URL; empty when absent
+}
+
+// String renders the canonical form of a, using the same quoting as
+// renderRouteExpr.
+func (a routeAction) String() string {
+	switch a.Func {
+	case "forward":
+		return "forward(" + quoteRouteString(a.Target) + ")"
+	case "store":
+		if a.Notify != "" {
+			return "store(notify=" + quoteRouteString(a.Notify) + ")"
+		}
+		return "store()"
+	}
+	return a.Func + "()"
+}
+
+// parseRouteAction parses one entry of a route's actions list and checks
+// forward targets and notify URLs.
+func parseRouteAction(src string) (routeAction, error) {
+	toks, err := lexRoute(src)
+	if err != nil {
+		return routeAction{}, err
+	}
+	p := &routeParser{toks: toks}
+	name := p.next()
+	if name.kind == routeTokEOF {
+		return routeAction{}, &routeSyntaxError{Pos: 0, Msg: "action is empty"}
+	}
+	if name.kind != routeTokIdent || (name.text != "forward" && name.text != "store" && name.text != "stop") {
+		return routeAction{}, &routeSyntaxError{Pos: name.pos, Msg: fmt.Sprintf(
+			"unknown action %s; expected forward, store or stop", name.describe())}
+	}
+	if _, err := p.expect(routeTokLParen, `"("`); err != nil {
+		return routeAction{}, err
+	}
+
+	a := routeAction{Func: name.text}
+	switch a.Func {
+	case "forward":
+		t, err := p.expect(routeTokString, "a quoted destination")
+		if err != nil {
+			return a, err
+		}
+		if err := checkForwardTarget(t.text); err != nil {
+			return a, &routeSyntaxError{Pos: t.pos, Msg: err.Error()}
+		}
+		a.Target = t.text
+	case "store":
+		if p.peek().kind == routeTokRParen {
+			break
+		}
+		if t := p.next(); t.kind != routeTokIdent || t.text != "notify" {
+			return a, &routeSyntaxError{Pos: t.pos, Msg: fmt.Sprintf(`expected "notify" or ")", found %s`, t.describe())}
+		}
+		if _, err := p.expect(routeTokEquals, `"="`); err != nil {
+			return a, err
+		}
+		t, err := p.expect(routeTokString, "a quoted notify URL")
+		if err != nil {
+			return a, err
+		}
+		if err := checkWebhookURL(t.text); err != nil {
+			return a, &routeSyntaxError{Pos: t.pos, Msg: err.Error()}
+		}
+		a.Notify = t.text
+	}
+
+	if _, err := p.expect(routeTokRParen, `")"`); err != nil {
+		return a, err
+	}
+	if t := p.peek(); t.kind != routeTokEOF {
+		return a, &routeSyntaxError{Pos: t.pos, Msg: fmt.Sprintf("expected end of action, found %s", t.describe())}
+	}
+	return a, nil
+}
+
+// checkForwardTarget accepts an http(s) URL or a bare email address.
+func checkForwardTarget(target string) error {
+	if strings.Contains(target, "://") {
+		return checkWebhookURL(target)
+	}
+	addr, err := mail.ParseAddress(target)
+	if err != nil || addr.Address != target {
+		return fmt.Errorf("%q is neither an email address nor an http(s) URL", target)
+	}
+	return nil
+}
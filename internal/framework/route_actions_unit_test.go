@@ -0,0 +1,79 @@
+package framework
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseRouteAction_Valid(t *testing.T) {
+	cases := map[string]string{
+		`forward("alice@example.com")`:               `forward("alice@example.com")`,
+		`forward('http://example.com/api/v1/foos/')`: `forward("http://example.com/api/v1/foos/")`,
+		`store()`: `store()`,
+		`store( notify = 'https://example.com/notify' )`: `store(notify="https://example.com/notify")`,
+		` stop() `: `stop()`,
+	}
+	for src, want := range cases {
+		a, err := parseRouteAction(src)
+		if err != nil {
+			t.Errorf("parseRouteAction(%q) unexpected error: %s", src, err)
+			continue
+		}
+		if got := a.String(); got != want {
+			t.Errorf("parseRouteAction(%q) = %q, want %q", src, got, want)
+		}
+	}
+}
+
+func TestParseRouteAction_Errors(t *testing.T) {
+	cases := []struct {
+		src     string
+		pos     int
+		message string
+	}{
+		{`foward("a@example.com")`, 0, "unknown action"},
+		{`forward("not an address")`, 8, "neither an email address nor"},
+		{`forward("ftp://example.com")`, 8, "http or https"},
+		{`store(notify="example.com/notify")`, 13, "http or https"},
+		{`store(url="https://example.com")`, 6, `expected "notify"`},
+		{`stop("now")`, 5, `expected ")"`},
+		{`stop() stop()`, 7, "expected end of action"},
+	}
+	for _, c := range cases {
+		_, err := parseRouteAction(c.src)
+		se, ok := err.(*routeSyntaxError)
+		if !ok {
+			t.Errorf("parseRouteAction(%q) error = %v, want routeSyntaxError", c.src, err)
+			continue
+		}
+		if se.Pos != c.pos || !strings.Contains(se.Msg, c.message) {
+			t.Errorf("parseRouteAction(%q) = %d %q, want %d %q", c.src, se.Pos, se.Msg, c.pos, c.message)
+		}
+	}
+}
+
+func TestRouteActionsValidator_StopNotLast(t *testing.T) {
+	ctx := context.Background()
+	validate := func(actions ...string) *validator.ListResponse {
+		value, _ := types.ListValueFrom(ctx, types.StringType, actions)
+		resp := &validator.ListResponse{}
+		validRouteActions().ValidateList(ctx, validator.ListRequest{Path: path.Root("actions"), ConfigValue: value}, resp)
+		return resp
+	}
+
+	if resp := validate(`forward("a@example.com")`, `stop()`); len(resp.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostics, got %v", resp.Diagnostics)
+	}
+	resp := validate(`stop()`, `forward("a@example.com")`)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a single warning, got %v", resp.Diagnostics)
+	}
+	if resp := validate(`forward("a@example.com")`, `bogus()`); resp.Diagnostics.ErrorsCount() != 1 {
+		t.Errorf("expected a single error, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,144 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	testRouteMatchType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"recipient": types.StringType, "header_name": types.StringType,
+		"header_value": types.StringType, "catch_all": types.BoolType,
+	}}
+	testRouteActionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"forward": types.StringType, "store": types.BoolType,
+		"notify": types.StringType, "stop": types.BoolType,
+	}}
+)
+
+func TestRouteExpressionFromBlocks(t *testing.T) {
+	ctx := context.Background()
+	blocks, _ := types.ListValueFrom(ctx, testRouteMatchType, []routeMatchModel{
+		{Recipient: types.StringValue(".*@example.com"), HeaderName: types.StringNull(), HeaderValue: types.StringNull(), CatchAll: types.BoolNull()},
+		{Recipient: types.StringNull(), HeaderName: types.StringValue("subject"), HeaderValue: types.StringValue(`say "hi"`), CatchAll: types.BoolNull()},
+	})
+
+	expr, ok, diags := routeExpressionFromBlocks(ctx, blocks)
+	if diags.HasError() || !ok {
+		t.Fatalf("unexpected failure: ok=%v %v", ok, diags)
+	}
+	want := `match_recipient(".*@example.com") and match_header("subject", "say \"hi\"")`
+	if expr != want {
+		t.Errorf("expression = %q, want %q", expr, want)
+	}
+
+	bad, _ := types.ListValueFrom(ctx, testRouteMatchType, []routeMatchModel{
+		{Recipient: types.StringValue("a"), HeaderName: types.StringNull(), HeaderValue: types.StringNull(), CatchAll: types.BoolValue(true)},
+	})
+	if _, ok, diags := routeExpressionFromBlocks(ctx, bad); ok || !diags.HasError() {
+		t.Error("a block setting both recipient and catch_all must be rejected")
+	}
+
+	unknown, _ := types.ListValueFrom(ctx, testRouteMatchType, []routeMatchModel{
+		{Recipient: types.StringUnknown(), HeaderName: types.StringNull(), HeaderValue: types.StringNull(), CatchAll: types.BoolNull()},
+	})
+	if _, ok, diags := routeExpressionFromBlocks(ctx, unknown); ok || diags.HasError() {
+		t.Error("unknown block values must defer rendering without errors")
+	}
+}
+
+func TestRouteActionsFromBlocks(t *testing.T) {
+	ctx := context.Background()
+	blocks, _ := types.ListValueFrom(ctx, testRouteActionType, []routeActionModel{
+		{Forward: types.StringValue("https://example.com/inbound"), Store: types.BoolNull(), Notify: types.StringNull(), Stop: types.BoolNull()},
+		{Forward: types.StringNull(), Store: types.BoolValue(true), Notify: types.StringValue("https://example.com/notify"), Stop: types.BoolNull()},
+		{Forward: types.StringNull(), Store: types.BoolNull(), Notify: types.StringNull(), Stop: types.BoolValue(true)},
+	})
+
+	list, ok, diags := routeActionsFromBlocks(ctx, blocks)
+	if diags.HasError() || !ok {
+		t.Fatalf("unexpected failure: ok=%v %v", ok, diags)
+	}
+	var got []string
+	list.ElementsAs(ctx, &got, false)
+	want := []string{`forward("https://example.com/inbound")`, `store(notify="https://example.com/notify")`, `stop()`}
+	if len(got) != len(want) {
+		t.Fatalf("actions = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("actions[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	bad, _ := types.ListValueFrom(ctx, testRouteActionType, []routeActionModel{
+		{Forward: types.StringNull(), Store: types.BoolNull(), Notify: types.StringValue("https://example.com"), Stop: types.BoolNull()},
+	})
+	if _, ok, diags := routeActionsFromBlocks(ctx, bad); ok || !diags.HasError() {
+		t.Error("notify without store must be rejected")
+	}
+}
+
+func TestRouteMatchBlocksFromExpression(t *testing.T) {
+	ctx := context.Background()
+	expr := `match_recipient('.*@example.com') and match_header("subject", "support")`
+
+	blocks, ok, diags := routeMatchBlocksFromExpression(ctx, expr)
+	if diags.HasError() || !ok {
+		t.Fatalf("unexpected failure: ok=%v %v", ok, diags)
+	}
+	// Rendering the rebuilt blocks gives back the same filter.
+	rendered, ok, diags := routeExpressionFromBlocks(ctx, blocks)
+	if diags.HasError() || !ok {
+		t.Fatalf("rendering rebuilt blocks failed: ok=%v %v", ok, diags)
+	}
+	if want := `match_recipient(".*@example.com") and match_header("subject", "support")`; rendered != want {
+		t.Errorf("rendered = %q, want %q", rendered, want)
+	}
+
+	blocks, ok, diags = routeMatchBlocksFromExpression(ctx, "catch_all()")
+	if diags.HasError() || !ok || len(blocks.Elements()) != 1 {
+		t.Errorf("catch_all: got %v ok=%v %v", blocks, ok, diags)
+	}
+
+	for _, expr := range []string{`match_recipient("a") or match_recipient("b")`, `match_recipient(`} {
+		if _, ok, diags := routeMatchBlocksFromExpression(ctx, expr); ok || diags.HasError() {
+			t.Errorf("%q: expected no blocks and no error, got ok=%v %v", expr, ok, diags)
+		}
+	}
+}
+
+func TestRouteActionBlocksFromActions(t *testing.T) {
+	ctx := context.Background()
+	actions, _ := types.ListValueFrom(ctx, routeActionType{}, []string{
+		`forward('https://example.com/inbound')`, `store(notify="https://example.com/notify")`, `stop()`,
+	})
+
+	blocks, ok, diags := routeActionBlocksFromActions(ctx, actions)
+	if diags.HasError() || !ok {
+		t.Fatalf("unexpected failure: ok=%v %v", ok, diags)
+	}
+	list, ok, diags := routeActionsFromBlocks(ctx, blocks)
+	if diags.HasError() || !ok {
+		t.Fatalf("rendering rebuilt blocks failed: ok=%v %v", ok, diags)
+	}
+	var got []string
+	list.ElementsAs(ctx, &got, false)
+	want := []string{`forward("https://example.com/inbound")`, `store(notify="https://example.com/notify")`, `stop()`}
+	if len(got) != len(want) {
+		t.Fatalf("actions = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("actions[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	bad, _ := types.ListValueFrom(ctx, routeActionType{}, []string{`drop()`})
+	if _, ok, diags := routeActionBlocksFromActions(ctx, bad); ok || diags.HasError() {
+		t.Errorf("an unknown action must leave the blocks out without error, got ok=%v %v", ok, diags)
+	}
+}
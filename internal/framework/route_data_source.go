@@ -0,0 +1,146 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ datasource.DataSource                     = (*routeDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*routeDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*routeDataSource)(nil)
+)
+
+// NewRouteDataSource is the constructor registered with the framework
+// provider for data "mailgun_route".
+func NewRouteDataSource() datasource.DataSource {
+	return &routeDataSource{}
+}
+
+type routeDataSource struct {
+	cfg *mailgunpkg.Config
+}
+
+func (d *routeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_route"
+}
+
+func (d *routeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Attributes: map[string]dsschema.Attribute{
+			"id":          dsschema.StringAttribute{Optional: true, Computed: true},
+			"description": dsschema.StringAttribute{Optional: true, Computed: true},
+			"region":      dsschema.StringAttribute{Optional: true, Computed: true},
+			"priority":    dsschema.Int64Attribute{Computed: true},
+			"expression":  dsschema.StringAttribute{Computed: true, CustomType: routeExpressionType{}},
+			"actions": dsschema.ListAttribute{
+				Computed:    true,
+				ElementType: routeActionType{},
+			},
+		},
+	}
+}
+
+func (d *routeDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("description")),
+	}
+}
+
+func (d *routeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	d.cfg = cfg
+}
+
+// Read resolves the route by id when given, otherwise by description, which
+// must then match exactly one route in the region.
+func (d *routeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data routeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = "us"
+	}
+
+	var route mtypes.Route
+	if id := data.ID.ValueString(); id != "" {
+		client, err := d.cfg.GetClient(region)
+		if err != nil {
+			resp.Diagnostics.AddError("Mailgun client error", err.Error())
+			return
+		}
+		route, err = client.GetRoute(ctx, id)
+		if err != nil {
+			if mailgunpkg.IsNotFound(err) {
+				resp.Diagnostics.AddError("Route not found",
+					fmt.Sprintf("Mailgun route %q does not exist", id))
+				return
+			}
+			resp.Diagnostics.AddError("Failed to read route", err.Error())
+			return
+		}
+	} else {
+		routes, err := d.cfg.ListRoutes(ctx, region)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list routes", err.Error())
+			return
+		}
+		var found bool
+		route, found, err = routeByDescription(routes, data.Description.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("description"), "Ambiguous route description", err.Error())
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddError("Route not found",
+				fmt.Sprintf("no Mailgun route has description %q", data.Description.ValueString()))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(route.Id)
+	data.Region = types.StringValue(region)
+	resp.Diagnostics.Append(applyRoute(ctx, &data, &route)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// routeByDescription returns the single route whose description equals desc.
+// Several matches are an error because the lookup would be ambiguous.
+func routeByDescription(routes []mtypes.Route, desc string) (mtypes.Route, bool, error) {
+	var match mtypes.Route
+	count := 0
+	for _, r := range routes {
+		if r.Description == desc {
+			match = r
+			count++
+		}
+	}
+	if count > 1 {
+		return mtypes.Route{}, false, fmt.Errorf("%d routes have description %q; look the route up by id instead", count, desc)
+	}
+	return match, count == 1, nil
+}
@@ -0,0 +1,393 @@
+package framework
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// This file implements a parser for Mailgun's route filter grammar:
+//
+//	expr    = and { "or" and }
+//	and     = primary { "and" primary }
+//	primary = "(" expr ")" | filter
+//	filter  = "match_recipient" "(" string ")"
+//	        | "match_header" "(" string "," string ")"
+//	        | "catch_all" "(" ")"
+//
+// Strings are single- or double-quoted. Backslashes are kept verbatim
+// because patterns are regular expressions, except before the quote
+// character: there they pair up, and an odd one escapes the quote, so
+// "a\\" is a followed by one backslash.
+
+// routeFilterArity lists the filters Mailgun understands with their number
+// of arguments.
+var routeFilterArity = map[string]int{
+	"match_recipient": 1,
+	"match_header":    2,
+	"catch_all":       0,
+}
+
+// routeSyntaxError reports a parse failure at a rune offset of the source.
+type routeSyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *routeSyntaxError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Pos+1, e.Msg)
+}
+
+// routeSyntaxDetail renders err followed by src with a caret under the
+// offending position, for use as a diagnostic detail.
+func routeSyntaxDetail(src string, err error) string {
+	se, ok := err.(*routeSyntaxError)
+	if !ok {
+		return err.Error()
+	}
+	return fmt.Sprintf("%s\n\n  %s\n  %s^", se.Error(), src, strings.Repeat(" ", se.Pos))
+}
+
+type routeTokenKind int
+
+const (
+	routeTokEOF routeTokenKind = iota
+	routeTokIdent
+	routeTokString
+	routeTokLParen
+	routeTokRParen
+	routeTokComma
+	routeTokEquals
+)
+
+type routeToken struct {
+	kind routeTokenKind
+	text string // identifier name or unquoted string value
+	pos  int
+}
+
+func (t routeToken) describe() string {
+	switch t.kind {
+	case routeTokEOF:
+		return "end of input"
+	case routeTokIdent:
+		return fmt.Sprintf("%q", t.text)
+	case routeTokString:
+		return "string"
+	case routeTokLParen:
+		return `"("`
+	case routeTokRParen:
+		return `")"`
+	case routeTokComma:
+		return `","`
+	case routeTokEquals:
+		return `"="`
+	}
+	return "token"
+}
+
+// lexRoute splits src into tokens. Route actions share the lexer, which is
+// why it also knows "=". Token positions are rune offsets.
+func lexRoute(src string) ([]routeToken, error) {
+	var toks []routeToken
+	pos := func(i int) int { return utf8.RuneCountInString(src[:i]) }
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			toks = append(toks, routeToken{kind: routeTokLParen, pos: pos(i)})
+			i++
+		case c == ')':
+			toks = append(toks, routeToken{kind: routeTokRParen, pos: pos(i)})
+			i++
+		case c == ',':
+			toks = append(toks, routeToken{kind: routeTokComma, pos: pos(i)})
+			i++
+		case c == '=':
+			toks = append(toks, routeToken{kind: routeTokEquals, pos: pos(i)})
+			i++
+		case c == '\'' || c == '"':
+			start := i
+			var b strings.Builder
+			i++
+			for {
+				if i >= len(src) {
+					return nil, &routeSyntaxError{Pos: pos(start), Msg: "unterminated string"}
+				}
+				if src[i] == '\\' {
+					n := 0
+					for i+n < len(src) && src[i+n] == '\\' {
+						n++
+					}
+					if i+n == len(src) || src[i+n] != c {
+						b.WriteString(src[i : i+n])
+						i += n
+						continue
+					}
+					// Before a quote, backslashes pair up and an odd one
+					// escapes the quote.
+					b.WriteString(strings.Repeat(`\`, n/2))
+					i += n
+					if n%2 == 1 {
+						b.WriteByte(c)
+						i++
+					}
+					continue
+				}
+				if src[i] == c {
+					i++
+					break
+				}
+				b.WriteByte(src[i])
+				i++
+			}
+			toks = append(toks, routeToken{kind: routeTokString, text: b.String(), pos: pos(start)})
+		case isRouteIdentByte(c):
+			start := i
+			for i < len(src) && isRouteIdentByte(src[i]) {
+				i++
+			}
+			toks = append(toks, routeToken{kind: routeTokIdent, text: src[start:i], pos: pos(start)})
+		default:
+			r, _ := utf8.DecodeRuneInString(src[i:])
+			return nil, &routeSyntaxError{Pos: pos(i), Msg: fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+	return append(toks, routeToken{kind: routeTokEOF, pos: pos(len(src))}), nil
+}
+
+func isRouteIdentByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// routeExpr is a node of a parsed route expression.
+type routeExpr interface {
+	render(b *strings.Builder, parentOp string)
+}
+
+// routeMatch is a single filter call such as match_header("subject", "x").
+type routeMatch struct {
+	Func string
+	Args []string
+}
+
+// routeBool joins two or more operands with "and" or "or". Nested operands
+// using the same operator are flattened.
+type routeBool struct {
+	Op       string
+	Operands []routeExpr
+}
+
+func (m *routeMatch) render(b *strings.Builder, _ string) {
+	b.WriteString(m.Func)
+	b.WriteByte('(')
+	for i, arg := range m.Args {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(quoteRouteString(arg))
+	}
+	b.WriteByte(')')
+}
+
+func (e *routeBool) render(b *strings.Builder, parentOp string) {
+	// "and" binds tighter than "or", so only an "or" nested in an "and"
+	// needs parentheses.
+	paren := parentOp == "and" && e.Op == "or"
+	if paren {
+		b.WriteByte('(')
+	}
+	for i, operand := range e.Operands {
+		if i > 0 {
+			b.WriteString(" " + e.Op + " ")
+		}
+		operand.render(b, e.Op)
+	}
+	if paren {
+		b.WriteByte(')')
+	}
+}
+
+// renderRouteExpr returns the canonical text of e: double-quoted strings,
+// single spaces and only the parentheses precedence requires.
+func renderRouteExpr(e routeExpr) string {
+	var b strings.Builder
+	e.render(&b, "")
+	return b.String()
+}
+
+// quoteRouteString double-quotes s so that lexRoute reads it back: embedded
+// double quotes are escaped and backslashes before them or before the
+// closing quote are doubled.
+func quoteRouteString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			n := 0
+			for i+n < len(s) && s[i+n] == '\\' {
+				n++
+			}
+			b.WriteString(s[i : i+n])
+			if i+n == len(s) || s[i+n] == '"' {
+				b.WriteString(s[i : i+n])
+			}
+			i += n - 1
+			continue
+		}
+		if s[i] == '"' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// parseRouteExpr parses a Mailgun route filter expression.
+func parseRouteExpr(src string) (routeExpr, error) {
+	toks, err := lexRoute(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &routeParser{toks: toks}
+	if p.peek().kind == routeTokEOF {
+		return nil, &routeSyntaxError{Pos: 0, Msg: "expression is empty"}
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != routeTokEOF {
+		return nil, &routeSyntaxError{Pos: t.pos, Msg: fmt.Sprintf(`expected "and", "or" or end of input, found %s`, t.describe())}
+	}
+	if _, combined := e.(*routeBool); combined && len(p.catchAll) > 0 {
+		return nil, &routeSyntaxError{Pos: p.catchAll[0], Msg: "catch_all() cannot be combined with other filters"}
+	}
+	return e, nil
+}
+
+type routeParser struct {
+	toks     []routeToken
+	i        int
+	catchAll []int // positions of catch_all() calls
+}
+
+func (p *routeParser) peek() routeToken { return p.toks[p.i] }
+
+func (p *routeParser) next() routeToken {
+	t := p.toks[p.i]
+	if t.kind != routeTokEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *routeParser) expect(kind routeTokenKind, what string) (routeToken, error) {
+	t := p.next()
+	if t.kind != kind {
+		return t, &routeSyntaxError{Pos: t.pos, Msg: fmt.Sprintf("expected %s, found %s", what, t.describe())}
+	}
+	return t, nil
+}
+
+func (p *routeParser) parseOr() (routeExpr, error) {
+	return p.parseBinary("or", p.parseAnd)
+}
+
+func (p *routeParser) parseAnd() (routeExpr, error) {
+	return p.parseBinary("and", p.parsePrimary)
+}
+
+func (p *routeParser) parseBinary(op string, operand func() (routeExpr, error)) (routeExpr, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []routeExpr{first}
+	for {
+		t := p.peek()
+		if t.kind != routeTokIdent || t.text != op {
+			break
+		}
+		p.next()
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, next)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	flat := &routeBool{Op: op}
+	for _, o := range operands {
+		if b, ok := o.(*routeBool); ok && b.Op == op {
+			flat.Operands = append(flat.Operands, b.Operands...)
+			continue
+		}
+		flat.Operands = append(flat.Operands, o)
+	}
+	return flat, nil
+}
+
+func (p *routeParser) parsePrimary() (routeExpr, error) {
+	t := p.next()
+	switch t.kind {
+	case routeTokLParen:
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(routeTokRParen, `")"`); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case routeTokIdent:
+		return p.parseFilter(t)
+	}
+	return nil, &routeSyntaxError{Pos: t.pos, Msg: fmt.Sprintf("expected a filter or \"(\", found %s", t.describe())}
+}
+
+func (p *routeParser) parseFilter(name routeToken) (routeExpr, error) {
+	arity, ok := routeFilterArity[name.text]
+	if !ok {
+		return nil, &routeSyntaxError{Pos: name.pos, Msg: fmt.Sprintf(
+			"unknown filter %q; expected match_recipient, match_header or catch_all", name.text)}
+	}
+	if _, err := p.expect(routeTokLParen, `"("`); err != nil {
+		return nil, err
+	}
+	m := &routeMatch{Func: name.text}
+	arityErr := func(t routeToken) error {
+		return &routeSyntaxError{Pos: t.pos, Msg: fmt.Sprintf(
+			"%s takes %d argument(s), found %s", name.text, arity, t.describe())}
+	}
+	for len(m.Args) < arity {
+		if len(m.Args) > 0 {
+			if t := p.next(); t.kind != routeTokComma {
+				return nil, arityErr(t)
+			}
+		}
+		arg, err := p.expect(routeTokString, "a quoted string")
+		if err != nil {
+			return nil, err
+		}
+		m.Args = append(m.Args, arg.text)
+	}
+	switch t := p.peek(); t.kind {
+	case routeTokRParen:
+	case routeTokComma, routeTokString:
+		return nil, arityErr(t)
+	default:
+		return nil, &routeSyntaxError{Pos: t.pos, Msg: fmt.Sprintf(`expected ")", found %s`, t.describe())}
+	}
+	p.next()
+	if name.text == "catch_all" {
+		p.catchAll = append(p.catchAll, name.pos)
+	}
+	return m, nil
+}
@@ -0,0 +1,105 @@
+package framework
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParseRouteExpr_Valid(t *testing.T) {
+	cases := map[string]string{
+		`match_recipient('.*@example.com')`:                                         `match_recipient(".*@example.com")`,
+		`match_header("subject", '.*support')`:                                      `match_header("subject", ".*support")`,
+		`catch_all()`:                                                               `catch_all()`,
+		`match_recipient('a')   and  match_header('x','y')`:                         `match_recipient("a") and match_header("x", "y")`,
+		`match_recipient('a') and (match_recipient('b') and match_recipient('c'))`:  `match_recipient("a") and match_recipient("b") and match_recipient("c")`,
+		`(match_recipient('a') or match_recipient('b')) and match_header('x', 'y')`: `(match_recipient("a") or match_recipient("b")) and match_header("x", "y")`,
+		`match_recipient('a') or match_recipient('b') and match_recipient('c')`:     `match_recipient("a") or match_recipient("b") and match_recipient("c")`,
+		`match_recipient('it\'s') and match_header('x', "say \"hi\"")`:              `match_recipient("it's") and match_header("x", "say \"hi\"")`,
+		`match_recipient('^foo\.bar@.*$')`:                                          `match_recipient("^foo\.bar@.*$")`,
+	}
+	for src, want := range cases {
+		e, err := parseRouteExpr(src)
+		if err != nil {
+			t.Errorf("parseRouteExpr(%q) unexpected error: %s", src, err)
+			continue
+		}
+		got := renderRouteExpr(e)
+		if got != want {
+			t.Errorf("renderRouteExpr(%q) = %q, want %q", src, got, want)
+		}
+		// The canonical form must itself parse to the same rendering.
+		again, err := parseRouteExpr(got)
+		if err != nil || renderRouteExpr(again) != got {
+			t.Errorf("canonical form %q does not round-trip", got)
+		}
+	}
+}
+
+func TestParseRouteExpr_Errors(t *testing.T) {
+	cases := []struct {
+		src     string
+		pos     int
+		message string
+	}{
+		{`match_recipent('.*')`, 0, `unknown filter "match_recipent"`},
+		{`match_recipient('.*'`, 20, `expected ")"`},
+		{`match_recipient('.*)`, 16, "unterminated string"},
+		{`match_header('subject')`, 22, "takes 2 argument(s)"},
+		{`match_recipient(.*)`, 16, "unexpected character"},
+		{`match_recipient('a') match_recipient('b')`, 21, `expected "and", "or"`},
+		{`match_recipient('a') and`, 24, "expected a filter"},
+		{`catch_all() and match_recipient('a')`, 0, "catch_all() cannot be combined"},
+		{``, 0, "expression is empty"},
+	}
+	for _, c := range cases {
+		_, err := parseRouteExpr(c.src)
+		se, ok := err.(*routeSyntaxError)
+		if !ok {
+			t.Errorf("parseRouteExpr(%q) error = %v, want routeSyntaxError", c.src, err)
+			continue
+		}
+		if se.Pos != c.pos || !strings.Contains(se.Msg, c.message) {
+			t.Errorf("parseRouteExpr(%q) = %d %q, want %d %q", c.src, se.Pos, se.Msg, c.pos, c.message)
+		}
+	}
+}
+
+func TestRouteSyntaxDetailPointsAtError(t *testing.T) {
+	src := `match_recipient('a') and match_recipent('b')`
+	_, err := parseRouteExpr(src)
+	detail := routeSyntaxDetail(src, err)
+	lines := strings.Split(detail, "\n")
+	caret := lines[len(lines)-1]
+	if strings.Index(caret, "^")-2 != strings.Index(src, "match_recipent") {
+		t.Errorf("caret misplaced:\n%s", detail)
+	}
+}
+
+func TestRouteString_RoundTrip(t *testing.T) {
+	for _, arg := range []string{`foo\`, `foo\\`, `a\"b`, `a\\"b`, `\d+@example\.com`, `"quoted"`, `\`, ``} {
+		src := renderRouteExpr(&routeMatch{Func: "match_recipient", Args: []string{arg}})
+		e, err := parseRouteExpr(src)
+		if err != nil {
+			t.Errorf("arg %q renders as %s, which does not parse: %s", arg, src, err)
+			continue
+		}
+		if got := e.(*routeMatch).Args[0]; got != arg {
+			t.Errorf("arg %q renders as %s, which parses back as %q", arg, src, got)
+		}
+	}
+}
+
+func TestRouteSyntaxError_RuneColumn(t *testing.T) {
+	src := `match_header('sübject', 'x') and match_recipent('b')`
+	_, err := parseRouteExpr(src)
+	want := strings.Index(src, "match_recipent")
+	want = len([]rune(src[:want]))
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("column %d:", want+1)) {
+		t.Errorf("error = %q, want column %d", err, want+1)
+	}
+	lines := strings.Split(routeSyntaxDetail(src, err), "\n")
+	if caret := lines[len(lines)-1]; strings.Index(caret, "^")-2 != want {
+		t.Errorf("caret at %d, want %d", strings.Index(caret, "^")-2, want)
+	}
+}
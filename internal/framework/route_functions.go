@@ -0,0 +1,148 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*routeFunction)(nil)
+
+// routeFunction is a provider-defined function that builds route DSL from
+// string arguments. Every route function shares the same shape, so they are
+// described by data instead of one type per function.
+type routeFunction struct {
+	name        string
+	summary     string
+	description string
+	params      []string // names of the positional string parameters
+	variadic    string   // name of the variadic string parameter, if any
+	build       func(args []string) (string, *function.FuncError)
+}
+
+// routeFunctions lists the constructors registered by the provider.
+func routeFunctions() []func() function.Function {
+	defs := []routeFunction{
+		{
+			name:        "match_recipient",
+			summary:     "Build a match_recipient route filter",
+			description: "Returns a match_recipient filter for the given recipient regular expression, quoted for use in a mailgun_route expression.",
+			params:      []string{"pattern"},
+			build: func(args []string) (string, *function.FuncError) {
+				return renderRouteExpr(&routeMatch{Func: "match_recipient", Args: args}), nil
+			},
+		},
+		{
+			name:        "match_header",
+			summary:     "Build a match_header route filter",
+			description: "Returns a match_header filter for the given header name and value regular expression, quoted for use in a mailgun_route expression.",
+			params:      []string{"name", "pattern"},
+			build: func(args []string) (string, *function.FuncError) {
+				return renderRouteExpr(&routeMatch{Func: "match_header", Args: args}), nil
+			},
+		},
+		{
+			name:        "route_and",
+			summary:     "Combine route filters with and",
+			description: "Returns the given route expressions combined with and. Every argument must be a valid route expression.",
+			variadic:    "expressions",
+			build:       combineRouteExprs("and"),
+		},
+		{
+			name:        "route_or",
+			summary:     "Combine route filters with or",
+			description: "Returns the given route expressions combined with or. Every argument must be a valid route expression.",
+			variadic:    "expressions",
+			build:       combineRouteExprs("or"),
+		},
+		{
+			name:        "forward",
+			summary:     "Build a forward route action",
+			description: "Returns a forward action for the given email address or http(s) URL, quoted for use in mailgun_route actions.",
+			params:      []string{"destination"},
+			build: func(args []string) (string, *function.FuncError) {
+				if err := checkForwardTarget(args[0]); err != nil {
+					return "", function.NewArgumentFuncError(0, err.Error())
+				}
+				return routeAction{Func: "forward", Target: args[0]}.String(), nil
+			},
+		},
+	}
+
+	fns := make([]func() function.Function, len(defs))
+	for i := range defs {
+		def := defs[i]
+		fns[i] = func() function.Function { return &def }
+	}
+	return fns
+}
+
+// combineRouteExprs returns a builder joining already rendered expressions
+// with op.
+func combineRouteExprs(op string) func(args []string) (string, *function.FuncError) {
+	return func(args []string) (string, *function.FuncError) {
+		if len(args) == 0 {
+			return "", function.NewFuncError("at least one expression is required")
+		}
+		combined := &routeBool{Op: op}
+		for i, arg := range args {
+			e, err := parseRouteExpr(arg)
+			if err != nil {
+				return "", function.NewArgumentFuncError(int64(i), fmt.Sprintf("invalid route expression: %s", err))
+			}
+			combined.Operands = append(combined.Operands, e)
+		}
+		if len(args) == 1 {
+			return renderRouteExpr(combined.Operands[0]), nil
+		}
+		out := renderRouteExpr(combined)
+		// Re-parse to flatten nested operators and reject catch_all().
+		e, err := parseRouteExpr(out)
+		if err != nil {
+			return "", function.NewFuncError(err.Error())
+		}
+		return renderRouteExpr(e), nil
+	}
+}
+
+func (f *routeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *routeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	def := function.Definition{
+		Summary:     f.summary,
+		Description: f.description,
+		Return:      function.StringReturn{},
+	}
+	for _, name := range f.params {
+		def.Parameters = append(def.Parameters, function.StringParameter{Name: name})
+	}
+	if f.variadic != "" {
+		def.VariadicParameter = function.StringParameter{Name: f.variadic}
+	}
+	resp.Definition = def
+}
+
+func (f *routeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	args := make([]string, len(f.params))
+	for i := range f.params {
+		resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.GetArgument(ctx, i, &args[i]))
+	}
+	if f.variadic != "" {
+		var rest []string
+		resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.GetArgument(ctx, len(f.params), &rest))
+		args = append(args, rest...)
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	out, ferr := f.build(args)
+	if ferr != nil {
+		resp.Error = ferr
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, out)
+}
@@ -0,0 +1,39 @@
+package framework_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Provider functions run without Mailgun access, so only TF_ACC is needed.
+func TestAccMailgunRouteFunctions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6Providers(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "expression" {
+  value = provider::mailgun::route_and(
+    provider::mailgun::match_recipient(".*@example.com"),
+    provider::mailgun::match_header("subject", "it's \"urgent\""),
+  )
+}
+
+output "action" {
+  value = provider::mailgun::forward("support@example.com")
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("expression",
+						`match_recipient(".*@example.com") and match_header("subject", "it's \"urgent\"")`),
+					resource.TestCheckOutput("action", `forward("support@example.com")`),
+				),
+			},
+		},
+	})
+}
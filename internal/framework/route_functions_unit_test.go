@@ -0,0 +1,88 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runRouteFunction calls the named provider function with string arguments;
+// variadic arguments are passed as the trailing tuple.
+func runRouteFunction(t *testing.T, name string, args []string, variadic []string) (string, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+	for _, newFn := range routeFunctions() {
+		fn := newFn()
+		meta := &function.MetadataResponse{}
+		fn.Metadata(ctx, function.MetadataRequest{}, meta)
+		if meta.Name != name {
+			continue
+		}
+		var values []attr.Value
+		for _, a := range args {
+			values = append(values, types.StringValue(a))
+		}
+		if variadic != nil {
+			elemTypes := make([]attr.Type, len(variadic))
+			elems := make([]attr.Value, len(variadic))
+			for i, v := range variadic {
+				elemTypes[i], elems[i] = types.StringType, types.StringValue(v)
+			}
+			values = append(values, types.TupleValueMust(elemTypes, elems))
+		}
+		resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+		fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(values)}, resp)
+		if resp.Error != nil {
+			return "", resp.Error
+		}
+		return resp.Result.Value().(types.String).ValueString(), nil
+	}
+	t.Fatalf("no function named %q", name)
+	return "", nil
+}
+
+func TestRouteFunctions(t *testing.T) {
+	cases := []struct {
+		name     string
+		args     []string
+		variadic []string
+		want     string
+	}{
+		{"match_recipient", []string{`.*@example.com`}, nil, `match_recipient(".*@example.com")`},
+		{"match_header", []string{"subject", `say "hi"`}, nil, `match_header("subject", "say \"hi\"")`},
+		{"forward", []string{"https://example.com/inbound"}, nil, `forward("https://example.com/inbound")`},
+		{"route_and", nil, []string{`match_recipient("a")`, `match_header('x', 'y')`}, `match_recipient("a") and match_header("x", "y")`},
+		{"route_and", nil, []string{`match_recipient("a") or match_recipient("b")`, `match_recipient("c")`},
+			`(match_recipient("a") or match_recipient("b")) and match_recipient("c")`},
+		{"route_or", nil, []string{`match_recipient("a") and match_recipient("b")`, `match_recipient("c")`},
+			`match_recipient("a") and match_recipient("b") or match_recipient("c")`},
+	}
+	for _, c := range cases {
+		got, err := runRouteFunction(t, c.name, c.args, c.variadic)
+		if err != nil {
+			t.Errorf("%s(%q, %q) error: %s", c.name, c.args, c.variadic, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%s(%q, %q) = %q, want %q", c.name, c.args, c.variadic, got, c.want)
+		}
+		if _, err := parseRouteExpr(got); err != nil && c.name != "forward" {
+			t.Errorf("%s produced an unparsable expression %q: %s", c.name, got, err)
+		}
+	}
+}
+
+func TestRouteFunctionErrors(t *testing.T) {
+	if _, err := runRouteFunction(t, "forward", []string{"not an address"}, nil); err == nil || err.FunctionArgument == nil || *err.FunctionArgument != 0 {
+		t.Errorf("forward should reject its argument, got %v", err)
+	}
+	if _, err := runRouteFunction(t, "route_and", nil, []string{`match_recipient("a")`, `match_recipent("b")`}); err == nil || err.FunctionArgument == nil || *err.FunctionArgument != 1 {
+		t.Errorf("route_and should point at the invalid argument, got %v", err)
+	}
+	if _, err := runRouteFunction(t, "route_and", nil, []string{`catch_all()`, `match_recipient("b")`}); err == nil {
+		t.Error("route_and must not combine catch_all()")
+	}
+}
@@ -2,14 +2,17 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mailgun/mailgun-go/v5/mtypes"
 )
 
 // buildRoutePayload converts the model into a Mailgun mtypes.Route value.
-func buildRoutePayload(ctx context.Context, m *routeResourceModel) (mtypes.Route, diag.Diagnostics) {
+func buildRoutePayload(ctx context.Context, m *routeModel) (mtypes.Route, diag.Diagnostics) {
 	var actions []string
 	diags := m.Actions.ElementsAs(ctx, &actions, false)
 	return mtypes.Route{
@@ -21,14 +24,252 @@ func buildRoutePayload(ctx context.Context, m *routeResourceModel) (mtypes.Route
 }
 
 // applyRoute syncs API-returned data back into the model.
-func applyRoute(ctx context.Context, m *routeResourceModel, r *mtypes.Route) diag.Diagnostics {
+func applyRoute(ctx context.Context, m *routeModel, r *mtypes.Route) diag.Diagnostics {
 	m.Priority = types.Int64Value(int64(r.Priority))
 	m.Description = types.StringValue(r.Description)
-	m.Expression = types.StringValue(r.Expression)
-	actions, d := types.ListValueFrom(ctx, types.StringType, r.Actions)
+	m.Expression = newRouteExpressionValue(r.Expression)
+	actions, d := types.ListValueFrom(ctx, routeActionType{}, r.Actions)
 	if d.HasError() {
 		return d
 	}
 	m.Actions = actions
 	return nil
 }
+
+// requireOneRouteSource reports an error unless exactly one of the raw
+// attribute and its structured block list is configured.
+func requireOneRouteSource(diags *diag.Diagnostics, attr string, attrNull bool, block string, blocks types.List) {
+	hasBlocks := blocks.IsUnknown() || len(blocks.Elements()) > 0
+	switch {
+	case attrNull && !hasBlocks:
+		diags.AddAttributeError(path.Root(attr), "Missing route "+attr,
+			fmt.Sprintf("Set either %q or at least one %q block.", attr, block))
+	case !attrNull && hasBlocks:
+		diags.AddAttributeError(path.Root(attr), "Conflicting route "+attr,
+			fmt.Sprintf("%q and %q blocks cannot be used together.", attr, block))
+	}
+}
+
+// routeExpressionFromBlocks renders match blocks into an expression, and-ing
+// the blocks together. ok is false when there are no blocks or some of their
+// values are not known yet.
+func routeExpressionFromBlocks(ctx context.Context, blocks types.List) (string, bool, diag.Diagnostics) {
+	var matches []routeMatchModel
+	if blocks.IsNull() || blocks.IsUnknown() {
+		return "", false, nil
+	}
+	diags := blocks.ElementsAs(ctx, &matches, false)
+	if diags.HasError() || len(matches) == 0 {
+		return "", false, diags
+	}
+
+	known := true
+	var operands []routeExpr
+	for i, m := range matches {
+		at := path.Root("match").AtListIndex(i)
+		if m.Recipient.IsUnknown() || m.HeaderName.IsUnknown() || m.HeaderValue.IsUnknown() || m.CatchAll.IsUnknown() {
+			known = false
+			continue
+		}
+		var set []routeExpr
+		if !m.Recipient.IsNull() {
+			set = append(set, &routeMatch{Func: "match_recipient", Args: []string{m.Recipient.ValueString()}})
+		}
+		if !m.HeaderName.IsNull() {
+			if m.HeaderValue.IsNull() {
+				diags.AddAttributeError(at.AtName("header_value"), "Missing header_value",
+					"header_value is required together with header_name.")
+				continue
+			}
+			set = append(set, &routeMatch{Func: "match_header", Args: []string{m.HeaderName.ValueString(), m.HeaderValue.ValueString()}})
+		} else if !m.HeaderValue.IsNull() {
+			diags.AddAttributeError(at.AtName("header_name"), "Missing header_name",
+				"header_value can only be used together with header_name.")
+			continue
+		}
+		if m.CatchAll.ValueBool() {
+			set = append(set, &routeMatch{Func: "catch_all"})
+		}
+		if len(set) != 1 {
+			diags.AddAttributeError(at, "Invalid match block",
+				"Set exactly one of recipient, header_name or catch_all = true.")
+			continue
+		}
+		operands = append(operands, set[0])
+	}
+	if diags.HasError() || !known {
+		return "", false, diags
+	}
+
+	var e routeExpr = &routeBool{Op: "and", Operands: operands}
+	if len(operands) == 1 {
+		e = operands[0]
+	}
+	expr := renderRouteExpr(e)
+	if _, err := parseRouteExpr(expr); err != nil {
+		diags.AddAttributeError(path.Root("match"), "Invalid match blocks", routeSyntaxDetail(expr, err))
+		return "", false, diags
+	}
+	return expr, true, diags
+}
+
+// routeActionsFromBlocks renders action blocks into action strings. ok is
+// false when there are no blocks or some of their values are not known yet.
+func routeActionsFromBlocks(ctx context.Context, blocks types.List) (types.List, bool, diag.Diagnostics) {
+	var actions []routeActionModel
+	if blocks.IsNull() || blocks.IsUnknown() {
+		return types.ListNull(routeActionType{}), false, nil
+	}
+	diags := blocks.ElementsAs(ctx, &actions, false)
+	if diags.HasError() || len(actions) == 0 {
+		return types.ListNull(routeActionType{}), false, diags
+	}
+
+	known := true
+	var rendered []string
+	for i, a := range actions {
+		at := path.Root("action").AtListIndex(i)
+		if a.Forward.IsUnknown() || a.Store.IsUnknown() || a.Notify.IsUnknown() || a.Stop.IsUnknown() {
+			known = false
+			continue
+		}
+		var set []routeAction
+		if !a.Forward.IsNull() {
+			if err := checkForwardTarget(a.Forward.ValueString()); err != nil {
+				diags.AddAttributeError(at.AtName("forward"), "Invalid forward target", err.Error())
+				continue
+			}
+			set = append(set, routeAction{Func: "forward", Target: a.Forward.ValueString()})
+		}
+		if a.Store.ValueBool() {
+			if !a.Notify.IsNull() {
+				if err := checkWebhookURL(a.Notify.ValueString()); err != nil {
+					diags.AddAttributeError(at.AtName("notify"), "Invalid notify URL", err.Error())
+					continue
+				}
+			}
+			set = append(set, routeAction{Func: "store", Notify: a.Notify.ValueString()})
+		} else if !a.Notify.IsNull() {
+			diags.AddAttributeError(at.AtName("notify"), "notify requires store",
+				"notify can only be used together with store = true.")
+			continue
+		}
+		if a.Stop.ValueBool() {
+			set = append(set, routeAction{Func: "stop"})
+		}
+		if len(set) != 1 {
+			diags.AddAttributeError(at, "Invalid action block",
+				"Set exactly one of forward, store = true or stop = true.")
+			continue
+		}
+		rendered = append(rendered, set[0].String())
+	}
+	if diags.HasError() || !known {
+		return types.ListNull(routeActionType{}), false, diags
+	}
+
+	list, d := types.ListValueFrom(ctx, routeActionType{}, rendered)
+	diags.Append(d...)
+	return list, !d.HasError(), diags
+}
+
+// routeMatchObjectType and routeActionObjectType describe the cty types of
+// match and action block elements.
+func routeMatchObjectType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"recipient":    types.StringType,
+		"header_name":  types.StringType,
+		"header_value": types.StringType,
+		"catch_all":    types.BoolType,
+	}}
+}
+
+func routeActionObjectType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"forward": types.StringType,
+		"store":   types.BoolType,
+		"notify":  types.StringType,
+		"stop":    types.BoolType,
+	}}
+}
+
+// routeMatchBlocksFromExpression is the inverse of routeExpressionFromBlocks.
+// ok is false when expr does not parse or uses "or", which blocks cannot
+// express.
+func routeMatchBlocksFromExpression(ctx context.Context, expr string) (types.List, bool, diag.Diagnostics) {
+	e, err := parseRouteExpr(expr)
+	if err != nil {
+		return types.ListNull(routeMatchObjectType()), false, nil
+	}
+	operands := []routeExpr{e}
+	if b, isBool := e.(*routeBool); isBool {
+		if b.Op != "and" {
+			return types.ListNull(routeMatchObjectType()), false, nil
+		}
+		operands = b.Operands
+	}
+
+	matches := make([]routeMatchModel, 0, len(operands))
+	for _, o := range operands {
+		f, isMatch := o.(*routeMatch)
+		if !isMatch {
+			return types.ListNull(routeMatchObjectType()), false, nil
+		}
+		m := routeMatchModel{
+			Recipient:   types.StringNull(),
+			HeaderName:  types.StringNull(),
+			HeaderValue: types.StringNull(),
+			CatchAll:    types.BoolNull(),
+		}
+		switch f.Func {
+		case "match_recipient":
+			m.Recipient = types.StringValue(f.Args[0])
+		case "match_header":
+			m.HeaderName, m.HeaderValue = types.StringValue(f.Args[0]), types.StringValue(f.Args[1])
+		case "catch_all":
+			m.CatchAll = types.BoolValue(true)
+		}
+		matches = append(matches, m)
+	}
+	list, diags := types.ListValueFrom(ctx, routeMatchObjectType(), matches)
+	return list, !diags.HasError(), diags
+}
+
+// routeActionBlocksFromActions is the inverse of routeActionsFromBlocks. ok
+// is false when some action does not parse.
+func routeActionBlocksFromActions(ctx context.Context, actions types.List) (types.List, bool, diag.Diagnostics) {
+	var rendered []string
+	diags := actions.ElementsAs(ctx, &rendered, false)
+	if diags.HasError() || len(rendered) == 0 {
+		return types.ListNull(routeActionObjectType()), false, diags
+	}
+
+	blocks := make([]routeActionModel, 0, len(rendered))
+	for _, src := range rendered {
+		a, err := parseRouteAction(src)
+		if err != nil {
+			return types.ListNull(routeActionObjectType()), false, diags
+		}
+		m := routeActionModel{
+			Forward: types.StringNull(),
+			Store:   types.BoolNull(),
+			Notify:  types.StringNull(),
+			Stop:    types.BoolNull(),
+		}
+		switch a.Func {
+		case "forward":
+			m.Forward = types.StringValue(a.Target)
+		case "store":
+			m.Store = types.BoolValue(true)
+			if a.Notify != "" {
+				m.Notify = types.StringValue(a.Notify)
+			}
+		case "stop":
+			m.Stop = types.BoolValue(true)
+		}
+		blocks = append(blocks, m)
+	}
+	list, d := types.ListValueFrom(ctx, routeActionObjectType(), blocks)
+	diags.Append(d...)
+	return list, !diags.HasError(), diags
+}
@@ -7,12 +7,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
@@ -22,6 +24,9 @@ var (
 	_ resource.Resource                = (*routeResource)(nil)
 	_ resource.ResourceWithImportState = (*routeResource)(nil)
 	_ resource.ResourceWithConfigure   = (*routeResource)(nil)
+
+	_ resource.ResourceWithValidateConfig = (*routeResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*routeResource)(nil)
 )
 
 // NewRouteResource is the constructor registered with the framework provider.
@@ -33,13 +38,37 @@ type routeResource struct {
 	cfg *mailgunpkg.Config
 }
 
+// routeModel holds the attributes shared by the mailgun_route resource and
+// data source.
+type routeModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Priority    types.Int64          `tfsdk:"priority"`
+	Region      types.String         `tfsdk:"region"`
+	Description types.String         `tfsdk:"description"`
+	Expression  routeExpressionValue `tfsdk:"expression"`
+	Actions     types.List           `tfsdk:"actions"`
+}
+
+// routeResourceModel adds the structured match and action blocks, which are
+// rendered into expression and actions at plan time.
 type routeResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Priority    types.Int64  `tfsdk:"priority"`
-	Region      types.String `tfsdk:"region"`
-	Description types.String `tfsdk:"description"`
-	Expression  types.String `tfsdk:"expression"`
-	Actions     types.List   `tfsdk:"actions"`
+	routeModel
+	Match  types.List `tfsdk:"match"`
+	Action types.List `tfsdk:"action"`
+}
+
+type routeMatchModel struct {
+	Recipient   types.String `tfsdk:"recipient"`
+	HeaderName  types.String `tfsdk:"header_name"`
+	HeaderValue types.String `tfsdk:"header_value"`
+	CatchAll    types.Bool   `tfsdk:"catch_all"`
+}
+
+type routeActionModel struct {
+	Forward types.String `tfsdk:"forward"`
+	Store   types.Bool   `tfsdk:"store"`
+	Notify  types.String `tfsdk:"notify"`
+	Stop    types.Bool   `tfsdk:"stop"`
 }
 
 func (r *routeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,11 +101,42 @@ func (r *routeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Default:  stringdefault.StaticString(""),
 			},
 			"expression": schema.StringAttribute{
-				Required: true,
+				Optional:   true,
+				Computed:   true,
+				CustomType: routeExpressionType{},
+				Validators: []validator.String{
+					validRouteExpression(),
+				},
 			},
 			"actions": schema.ListAttribute{
-				Required:    true,
-				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				ElementType: routeActionType{},
+				Validators: []validator.List{
+					validRouteActions(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"match": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"recipient":    schema.StringAttribute{Optional: true},
+						"header_name":  schema.StringAttribute{Optional: true},
+						"header_value": schema.StringAttribute{Optional: true},
+						"catch_all":    schema.BoolAttribute{Optional: true},
+					},
+				},
+			},
+			"action": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"forward": schema.StringAttribute{Optional: true},
+						"store":   schema.BoolAttribute{Optional: true},
+						"notify":  schema.StringAttribute{Optional: true},
+						"stop":    schema.BoolAttribute{Optional: true},
+					},
+				},
 			},
 		},
 	}
@@ -104,6 +164,53 @@ func (r *routeResource) ImportState(ctx context.Context, req resource.ImportStat
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedRouteKey, []byte("true"))...)
+}
+
+// importedRouteKey marks, in private state, a route imported but not yet
+// read. The first Read rebuilds the match and action blocks from what
+// Mailgun returns and clears the marker.
+const importedRouteKey = "imported_route"
+
+// ValidateConfig requires exactly one of expression or match blocks and of
+// actions or action blocks, and checks the blocks themselves.
+func (r *routeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config routeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requireOneRouteSource(&resp.Diagnostics, "expression", config.Expression.IsNull(), "match", config.Match)
+	requireOneRouteSource(&resp.Diagnostics, "actions", config.Actions.IsNull(), "action", config.Action)
+	_, _, d := routeExpressionFromBlocks(ctx, config.Match)
+	resp.Diagnostics.Append(d...)
+	_, _, d = routeActionsFromBlocks(ctx, config.Action)
+	resp.Diagnostics.Append(d...)
+}
+
+// ModifyPlan renders match and action blocks into expression and actions so
+// the plan shows the DSL that will be sent to Mailgun.
+func (r *routeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var config routeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expr, ok, d := routeExpressionFromBlocks(ctx, config.Match)
+	resp.Diagnostics.Append(d...)
+	if ok {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expression"), expr)...)
+	}
+	actions, ok, d := routeActionsFromBlocks(ctx, config.Action)
+	resp.Diagnostics.Append(d...)
+	if ok {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("actions"), actions)...)
+	}
 }
 
 func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -119,7 +226,7 @@ func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	opts, d := buildRoutePayload(ctx, &plan)
+	opts, d := buildRoutePayload(ctx, &plan.routeModel)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -133,7 +240,7 @@ func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	plan.ID = types.StringValue(created.Id)
-	resp.Diagnostics.Append(applyRoute(ctx, &plan, &created)...)
+	resp.Diagnostics.Append(applyRoute(ctx, &plan.routeModel, &created)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -164,13 +271,42 @@ func (r *routeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	resp.Diagnostics.Append(applyRoute(ctx, &state, &got)...)
+	resp.Diagnostics.Append(applyRoute(ctx, &state.routeModel, &got)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	imported, d := req.Private.GetKey(ctx, importedRouteKey)
+	resp.Diagnostics.Append(d...)
+	if len(imported) > 0 {
+		resp.Diagnostics.Append(rebuildRouteBlocks(ctx, &state)...)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedRouteKey, nil)...)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// rebuildRouteBlocks fills the match and action blocks of an imported route,
+// which are null after import, when its expression and actions can be
+// written as blocks. Routes that cannot keep the raw attributes only.
+func rebuildRouteBlocks(ctx context.Context, m *routeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if m.Match.IsNull() {
+		blocks, ok, d := routeMatchBlocksFromExpression(ctx, m.Expression.ValueString())
+		diags.Append(d...)
+		if ok {
+			m.Match = blocks
+		}
+	}
+	if m.Action.IsNull() {
+		blocks, ok, d := routeActionBlocksFromActions(ctx, m.Actions)
+		diags.Append(d...)
+		if ok {
+			m.Action = blocks
+		}
+	}
+	return diags
+}
+
 func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan routeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -184,7 +320,7 @@ func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	opts, d := buildRoutePayload(ctx, &plan)
+	opts, d := buildRoutePayload(ctx, &plan.routeModel)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -198,7 +334,7 @@ func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	plan.ID = types.StringValue(updated.Id)
-	resp.Diagnostics.Append(applyRoute(ctx, &plan, &updated)...)
+	resp.Diagnostics.Append(applyRoute(ctx, &plan.routeModel, &updated)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
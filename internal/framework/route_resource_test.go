@@ -53,6 +53,8 @@ func TestAccMailgunRoute_Import(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Import fills the blocks from the expression and actions.
+				ImportStateVerifyIgnore: []string{"match", "action"},
 			},
 		},
 	})
@@ -125,6 +127,35 @@ func TestAccMailgunRoute_Recreate(t *testing.T) {
 	})
 }
 
+func TestAccMailgunRoute_Blocks(t *testing.T) {
+	var route mtypes.Route
+	resourceName := "mailgun_route.blocks"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckMailgunRouteConfigBlocks,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMailgunRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "expression",
+						`match_recipient(".*@example.com") and match_header("subject", "support")`),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0", `forward("http://example.com/api/v1/foos/")`),
+					resource.TestCheckResourceAttr(resourceName, "actions.1", "stop()"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckMailgunRouteDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "mailgun_route" {
@@ -193,3 +224,25 @@ resource "mailgun_route" "foobar" {
     ]
 }
 `
+
+const testAccCheckMailgunRouteConfigBlocks = `
+resource "mailgun_route" "blocks" {
+    priority = "0"
+    description = "inbound blocks"
+
+    match {
+        recipient = ".*@example.com"
+    }
+    match {
+        header_name  = "subject"
+        header_value = "support"
+    }
+
+    action {
+        forward = "http://example.com/api/v1/foos/"
+    }
+    action {
+        stop = true
+    }
+}
+`
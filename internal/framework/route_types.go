@@ -0,0 +1,144 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Route expressions and actions are stored as custom string types whose
+// semantic equality compares the canonical rendering of the parsed DSL, so
+// quoting and whitespace differences between configuration and what Mailgun
+// returns do not show up as changes. Values that do not parse fall back to
+// exact comparison.
+
+var (
+	_ basetypes.StringTypable                    = routeExpressionType{}
+	_ basetypes.StringValuableWithSemanticEquals = routeExpressionValue{}
+	_ basetypes.StringTypable                    = routeActionType{}
+	_ basetypes.StringValuableWithSemanticEquals = routeActionValue{}
+)
+
+// canonicalRouteExpression returns the canonical form of src, or src itself
+// when it does not parse.
+func canonicalRouteExpression(src string) string {
+	e, err := parseRouteExpr(src)
+	if err != nil {
+		return src
+	}
+	return renderRouteExpr(e)
+}
+
+// canonicalRouteAction returns the canonical form of src, or src itself when
+// it does not parse.
+func canonicalRouteAction(src string) string {
+	a, err := parseRouteAction(src)
+	if err != nil {
+		return src
+	}
+	return a.String()
+}
+
+type routeExpressionType struct {
+	basetypes.StringType
+}
+
+func (t routeExpressionType) Equal(o attr.Type) bool {
+	other, ok := o.(routeExpressionType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t routeExpressionType) String() string { return "routeExpressionType" }
+
+func (t routeExpressionType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return routeExpressionValue{StringValue: in}, nil
+}
+
+func (t routeExpressionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := v.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+	return routeExpressionValue{StringValue: s}, nil
+}
+
+func (t routeExpressionType) ValueType(_ context.Context) attr.Value { return routeExpressionValue{} }
+
+type routeExpressionValue struct {
+	basetypes.StringValue
+}
+
+func newRouteExpressionValue(s string) routeExpressionValue {
+	return routeExpressionValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func (v routeExpressionValue) Type(_ context.Context) attr.Type { return routeExpressionType{} }
+
+func (v routeExpressionValue) Equal(o attr.Value) bool {
+	other, ok := o.(routeExpressionValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v routeExpressionValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(routeExpressionValue)
+	if !ok {
+		return false, nil
+	}
+	return canonicalRouteExpression(v.ValueString()) == canonicalRouteExpression(newValue.ValueString()), nil
+}
+
+type routeActionType struct {
+	basetypes.StringType
+}
+
+func (t routeActionType) Equal(o attr.Type) bool {
+	other, ok := o.(routeActionType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t routeActionType) String() string { return "routeActionType" }
+
+func (t routeActionType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return routeActionValue{StringValue: in}, nil
+}
+
+func (t routeActionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := v.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+	return routeActionValue{StringValue: s}, nil
+}
+
+func (t routeActionType) ValueType(_ context.Context) attr.Value { return routeActionValue{} }
+
+type routeActionValue struct {
+	basetypes.StringValue
+}
+
+func (v routeActionValue) Type(_ context.Context) attr.Type { return routeActionType{} }
+
+func (v routeActionValue) Equal(o attr.Value) bool {
+	other, ok := o.(routeActionValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v routeActionValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(routeActionValue)
+	if !ok {
+		return false, nil
+	}
+	return canonicalRouteAction(v.ValueString()) == canonicalRouteAction(newValue.ValueString()), nil
+}
@@ -0,0 +1,51 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestRouteExpressionSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{`match_recipient('.*@example.com')`, `match_recipient(".*@example.com")`, true},
+		{`match_recipient('a')  and match_header('x','y')`, `match_recipient("a") and match_header("x", "y")`, true},
+		{`(match_recipient('a') and match_recipient('b'))`, `match_recipient('a') and match_recipient('b')`, true},
+		{`match_recipient('a')`, `match_recipient('b')`, false},
+		{`not a filter(`, `not a filter(`, true},
+		{`not a filter(`, `not a  filter(`, false},
+	}
+	for _, c := range cases {
+		got, diags := newRouteExpressionValue(c.a).StringSemanticEquals(ctx, newRouteExpressionValue(c.b))
+		if diags.HasError() || got != c.want {
+			t.Errorf("semantic equality of %q and %q = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestRouteActionSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	action := func(s string) routeActionValue {
+		return routeActionValue{StringValue: basetypes.NewStringValue(s)}
+	}
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{`forward('http://example.com/api/v1/foos/')`, `forward("http://example.com/api/v1/foos/")`, true},
+		{`store( notify = 'https://example.com' )`, `store(notify="https://example.com")`, true},
+		{`stop()`, ` stop( ) `, true},
+		{`forward("a@example.com")`, `forward("b@example.com")`, false},
+	}
+	for _, c := range cases {
+		got, diags := action(c.a).StringSemanticEquals(ctx, action(c.b))
+		if diags.HasError() || got != c.want {
+			t.Errorf("semantic equality of %q and %q = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ validator.String = routeExpressionValidator{}
+
+// routeExpressionValidator parses expression at plan time so typos surface
+// before Mailgun rejects the route mid-apply.
+type routeExpressionValidator struct{}
+
+func validRouteExpression() validator.String { return routeExpressionValidator{} }
+
+func (v routeExpressionValidator) Description(_ context.Context) string {
+	return "value must be a valid Mailgun route filter expression"
+}
+
+func (v routeExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v routeExpressionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	src := req.ConfigValue.ValueString()
+	if _, err := parseRouteExpr(src); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid route expression", routeSyntaxDetail(src, err))
+	}
+}
+
+var _ validator.List = routeActionsValidator{}
+
+// routeActionsValidator parses every action and warns when stop() is
+// followed by further actions.
+type routeActionsValidator struct{}
+
+func validRouteActions() validator.List { return routeActionsValidator{} }
+
+func (v routeActionsValidator) Description(_ context.Context) string {
+	return "each value must be a forward(), store() or stop() route action"
+}
+
+func (v routeActionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v routeActionsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	elems := req.ConfigValue.Elements()
+	for i, elem := range elems {
+		sv, ok := elem.(basetypes.StringValuable)
+		if !ok {
+			continue
+		}
+		s, d := sv.ToStringValue(ctx)
+		if d.HasError() || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		src := s.ValueString()
+		a, err := parseRouteAction(src)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i), "Invalid route action", routeSyntaxDetail(src, err))
+			continue
+		}
+		if a.Func == "stop" && i < len(elems)-1 {
+			resp.Diagnostics.AddAttributeWarning(req.Path.AtListIndex(i), "stop() is not the last action",
+				"stop() only keeps lower-priority routes from being evaluated; actions listed after it are easy to "+
+					"misread as skipped. Move stop() to the end of the list.")
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ datasource.DataSource              = (*routesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*routesDataSource)(nil)
+)
+
+// NewRoutesDataSource is the constructor registered with the framework
+// provider for data "mailgun_routes".
+func NewRoutesDataSource() datasource.DataSource {
+	return &routesDataSource{}
+}
+
+type routesDataSource struct {
+	cfg *mailgunpkg.Config
+}
+
+type routesDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Region              types.String `tfsdk:"region"`
+	MinPriority         types.Int64  `tfsdk:"min_priority"`
+	MaxPriority         types.Int64  `tfsdk:"max_priority"`
+	DescriptionContains types.String `tfsdk:"description_contains"`
+	ExpressionRegex     types.String `tfsdk:"expression_regex"`
+	Routes              types.List   `tfsdk:"routes"`
+}
+
+// routeSummaryModel mirrors a routes element.
+type routeSummaryModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Priority    types.Int64          `tfsdk:"priority"`
+	Description types.String         `tfsdk:"description"`
+	Expression  routeExpressionValue `tfsdk:"expression"`
+	Actions     types.List           `tfsdk:"actions"`
+}
+
+func routeSummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"priority":    types.Int64Type,
+		"description": types.StringType,
+		"expression":  routeExpressionType{},
+		"actions":     types.ListType{ElemType: routeActionType{}},
+	}
+}
+
+func (d *routesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routes"
+}
+
+func (d *routesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Attributes: map[string]dsschema.Attribute{
+			"id":                   dsschema.StringAttribute{Computed: true},
+			"region":               dsschema.StringAttribute{Optional: true, Computed: true},
+			"min_priority":         dsschema.Int64Attribute{Optional: true},
+			"max_priority":         dsschema.Int64Attribute{Optional: true},
+			"description_contains": dsschema.StringAttribute{Optional: true},
+			"expression_regex": dsschema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{validRegex()},
+			},
+			"routes": dsschema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id":          dsschema.StringAttribute{Computed: true},
+						"priority":    dsschema.Int64Attribute{Computed: true},
+						"description": dsschema.StringAttribute{Computed: true},
+						"expression":  dsschema.StringAttribute{Computed: true, CustomType: routeExpressionType{}},
+						"actions": dsschema.ListAttribute{
+							Computed:    true,
+							ElementType: routeActionType{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *routesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	d.cfg = cfg
+}
+
+func (d *routesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data routesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := routeFilter{description: data.DescriptionContains.ValueString()}
+	if !data.MinPriority.IsNull() {
+		v := int(data.MinPriority.ValueInt64())
+		filter.minPriority = &v
+	}
+	if !data.MaxPriority.IsNull() {
+		v := int(data.MaxPriority.ValueInt64())
+		filter.maxPriority = &v
+	}
+	if v := data.ExpressionRegex.ValueString(); v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expression_regex"), "Invalid expression_regex", err.Error())
+			return
+		}
+		filter.expression = re
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = "us"
+	}
+	routes, err := d.cfg.ListRoutes(ctx, region)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list routes", err.Error())
+		return
+	}
+
+	summaries := []routeSummaryModel{}
+	for i := range routes {
+		if !filter.matches(routes[i]) {
+			continue
+		}
+		s, diags := routeSummaryFromAPI(ctx, &routes[i])
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		summaries = append(summaries, s)
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: routeSummaryAttrTypes()}, summaries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(region)
+	data.Region = types.StringValue(region)
+	data.Routes = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// routeFilter holds the optional mailgun_routes filters. Zero values match
+// every route; the priority bounds are inclusive.
+type routeFilter struct {
+	minPriority *int
+	maxPriority *int
+	description string
+	expression  *regexp.Regexp
+}
+
+func (f routeFilter) matches(r mtypes.Route) bool {
+	if f.minPriority != nil && r.Priority < *f.minPriority {
+		return false
+	}
+	if f.maxPriority != nil && r.Priority > *f.maxPriority {
+		return false
+	}
+	if f.description != "" && !strings.Contains(r.Description, f.description) {
+		return false
+	}
+	if f.expression != nil && !f.expression.MatchString(r.Expression) {
+		return false
+	}
+	return true
+}
+
+// routeSummaryFromAPI maps a listed route to its summary object through
+// applyRoute, so every element agrees with mailgun_route and data
+// "mailgun_route" for the same route.
+func routeSummaryFromAPI(ctx context.Context, r *mtypes.Route) (routeSummaryModel, diag.Diagnostics) {
+	var m routeModel
+	diags := applyRoute(ctx, &m, r)
+	return routeSummaryModel{
+		ID:          types.StringValue(r.Id),
+		Priority:    m.Priority,
+		Description: m.Description,
+		Expression:  m.Expression,
+		Actions:     m.Actions,
+	}, diags
+}
@@ -0,0 +1,65 @@
+package framework_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMailgunRouteDataSources_Basic(t *testing.T) {
+	id, _ := uuid.GenerateUUID()
+	description := fmt.Sprintf("tf-acc-route-%s", id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunRouteDataSourcesConfig(description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.mailgun_route.by_description", "id", "mailgun_route.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.mailgun_route.by_description", "expression", "match_recipient('.*@datasource.example.com')"),
+					resource.TestCheckResourceAttr("data.mailgun_route.by_id", "description", description),
+					resource.TestCheckResourceAttr("data.mailgun_route.by_id", "actions.#", "2"),
+					resource.TestCheckResourceAttr("data.mailgun_routes.test", "routes.#", "1"),
+					resource.TestCheckResourceAttr("data.mailgun_routes.test", "routes.0.priority", "7"),
+					resource.TestCheckResourceAttr("data.mailgun_routes.test", "routes.0.actions.1", "stop()"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMailgunRouteDataSourcesConfig(description string) string {
+	return fmt.Sprintf(`
+resource "mailgun_route" "foobar" {
+    priority = "7"
+    description = "%s"
+    expression = "match_recipient('.*@datasource.example.com')"
+    actions = [
+        "forward('http://example.com/api/v1/foos/')",
+        "stop()"
+    ]
+}
+
+data "mailgun_route" "by_id" {
+    id = mailgun_route.foobar.id
+}
+
+data "mailgun_route" "by_description" {
+    description = mailgun_route.foobar.description
+}
+
+data "mailgun_routes" "test" {
+    min_priority         = 5
+    max_priority         = 9
+    description_contains = mailgun_route.foobar.description
+    expression_regex     = "datasource\\.example\\.com"
+}
+`, description)
+}
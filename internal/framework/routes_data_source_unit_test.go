@@ -0,0 +1,80 @@
+package framework
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+)
+
+func TestRouteFilter(t *testing.T) {
+	r := mtypes.Route{Priority: 5, Description: "billing inbound", Expression: "match_recipient('.*@billing.example.com')"}
+
+	lo, hi, above := 1, 5, 6
+	cases := []struct {
+		name   string
+		filter routeFilter
+		want   bool
+	}{
+		{"empty filter", routeFilter{}, true},
+		{"inclusive range", routeFilter{minPriority: &lo, maxPriority: &hi}, true},
+		{"below min", routeFilter{minPriority: &above}, false},
+		{"above max", routeFilter{maxPriority: &lo}, false},
+		{"description substring", routeFilter{description: "inbound"}, true},
+		{"description mismatch", routeFilter{description: "support"}, false},
+		{"expression regex", routeFilter{expression: regexp.MustCompile(`billing\.example`)}, true},
+		{"expression mismatch", routeFilter{expression: regexp.MustCompile(`^catch_all`)}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.filter.matches(r); got != tc.want {
+			t.Errorf("%s: matches = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRouteByDescription(t *testing.T) {
+	routes := []mtypes.Route{
+		{Id: "1", Description: "inbound"},
+		{Id: "2", Description: "support"},
+		{Id: "3", Description: "support"},
+	}
+
+	got, found, err := routeByDescription(routes, "inbound")
+	if err != nil || !found || got.Id != "1" {
+		t.Errorf("unique description: got %#v found=%v err=%v", got, found, err)
+	}
+	if _, found, err := routeByDescription(routes, "missing"); err != nil || found {
+		t.Errorf("missing description: found=%v err=%v", found, err)
+	}
+	if _, _, err := routeByDescription(routes, "support"); err == nil {
+		t.Error("duplicate description should be reported as ambiguous")
+	}
+}
+
+func TestRouteSummaryFromAPI_MatchesApplyRoute(t *testing.T) {
+	ctx := context.Background()
+	r := mtypes.Route{
+		Id:          "r1",
+		Priority:    3,
+		Description: "inbound",
+		Expression:  "match_recipient('.*@example.com')",
+		Actions:     []string{"forward('ops@example.com')", "stop()"},
+	}
+
+	got, diags := routeSummaryFromAPI(ctx, &r)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	var want routeModel
+	if diags := applyRoute(ctx, &want, &r); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if got.ID.ValueString() != "r1" {
+		t.Errorf("id = %q", got.ID.ValueString())
+	}
+	if !got.Priority.Equal(want.Priority) || !got.Description.Equal(want.Description) ||
+		!got.Expression.Equal(want.Expression) || !got.Actions.Equal(want.Actions) {
+		t.Errorf("summary %#v does not match applyRoute %#v", got, want)
+	}
+}
@@ -3,6 +3,8 @@ package framework
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -45,3 +47,56 @@ func refreshWebhook(ctx context.Context, client *mailgun.Client, m *webhookResou
 	m.URLs = urlSet
 	return nil
 }
+
+// managedWebhooks keeps the kinds listed in allowedWebhookKinds. Anything else
+// the API reports is left alone by the resources in this package.
+func managedWebhooks(hooks map[string][]string) map[string][]string {
+	out := map[string][]string{}
+	for _, kind := range allowedWebhookKinds {
+		if urls, ok := hooks[kind]; ok && len(urls) > 0 {
+			out[kind] = urls
+		}
+	}
+	return out
+}
+
+type webhookAction string
+
+const (
+	webhookCreate webhookAction = "create"
+	webhookUpdate webhookAction = "update"
+	webhookDelete webhookAction = "delete"
+)
+
+type webhookOp struct {
+	action webhookAction
+	kind   string
+	urls   []string
+}
+
+// planWebhookSync returns the calls needed to turn current into desired, in
+// the order of allowedWebhookKinds. Kinds whose URL sets already match are
+// skipped and kinds missing from desired are deleted.
+func planWebhookSync(current, desired map[string][]string) []webhookOp {
+	var ops []webhookOp
+	for _, kind := range allowedWebhookKinds {
+		want, wanted := desired[kind]
+		have, exists := current[kind]
+		switch {
+		case wanted && !exists:
+			ops = append(ops, webhookOp{action: webhookCreate, kind: kind, urls: want})
+		case wanted && !sameURLSet(have, want):
+			ops = append(ops, webhookOp{action: webhookUpdate, kind: kind, urls: want})
+		case !wanted && exists:
+			ops = append(ops, webhookOp{action: webhookDelete, kind: kind})
+		}
+	}
+	return ops
+}
+
+func sameURLSet(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	sort.Strings(a)
+	sort.Strings(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -42,9 +43,23 @@ type webhookResourceModel struct {
 	URLs   types.Set    `tfsdk:"urls"`
 }
 
+// allowedWebhookKinds is the single list of webhook event kinds the provider
+// accepts. Validation, schema descriptions and the docs consistency test all
+// read from it, so supporting a new Mailgun event only means adding it here.
 var allowedWebhookKinds = []string{
 	"accepted", "clicked", "complained", "delivered", "opened",
 	"permanent_fail", "temporary_fail", "unsubscribed",
+	"list_member_uploaded", "list_member_upload_error", "list_uploaded",
+}
+
+// webhookKindsDescription renders allowedWebhookKinds for schema
+// descriptions.
+func webhookKindsDescription() string {
+	quoted := make([]string, len(allowedWebhookKinds))
+	for i, kind := range allowedWebhookKinds {
+		quoted[i] = "`" + kind + "`"
+	}
+	return strings.Join(quoted, ", ")
 }
 
 func (r *webhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -75,7 +90,8 @@ func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"kind": schema.StringAttribute{
-				Required: true,
+				Required:            true,
+				MarkdownDescription: "The kind of webhook. One of " + webhookKindsDescription() + ".",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -86,6 +102,11 @@ func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"urls": schema.SetAttribute{
 				Required:    true,
 				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, maxWebhookURLs),
+					setvalidator.ValueStringsAre(webhookURL()),
+					uniqueWebhookURLs(),
+				},
 			},
 		},
 	}
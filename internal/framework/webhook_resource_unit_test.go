@@ -0,0 +1,26 @@
+package framework
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestWebhookKindDocs keeps the hand-written docs in step with
+// allowedWebhookKinds.
+func TestWebhookKindDocs(t *testing.T) {
+	for _, doc := range []string{
+		"../../docs/resources/webhook.md",
+		"../../docs/resources/domain_webhooks.md",
+		"../../docs/resources/account_webhook.md",
+		"../../docs/data-sources/webhooks.md",
+	} {
+		raw, err := os.ReadFile(doc)
+		if err != nil {
+			t.Fatalf("read %s: %s", doc, err)
+		}
+		if !strings.Contains(string(raw), webhookKindsDescription()) {
+			t.Errorf("%s does not list the supported kinds as %s", doc, webhookKindsDescription())
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ datasource.DataSource              = (*webhookSigningKeyDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*webhookSigningKeyDataSource)(nil)
+)
+
+// NewWebhookSigningKeyDataSource is the constructor registered with the
+// framework provider for data "mailgun_webhook_signing_key".
+func NewWebhookSigningKeyDataSource() datasource.DataSource {
+	return &webhookSigningKeyDataSource{}
+}
+
+type webhookSigningKeyDataSource struct {
+	cfg *mailgunpkg.Config
+}
+
+type webhookSigningKeyModel struct {
+	ID        types.String `tfsdk:"id"`
+	Region    types.String `tfsdk:"region"`
+	Key       types.String `tfsdk:"key"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *webhookSigningKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_signing_key"
+}
+
+func (d *webhookSigningKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Attributes: map[string]dsschema.Attribute{
+			"id":         dsschema.StringAttribute{Computed: true},
+			"region":     dsschema.StringAttribute{Optional: true, Computed: true},
+			"key":        dsschema.StringAttribute{Computed: true, Sensitive: true},
+			"created_at": dsschema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (d *webhookSigningKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	d.cfg = cfg
+}
+
+func (d *webhookSigningKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webhookSigningKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = "us"
+	}
+	key, err := d.cfg.GetWebhookSigningKey(ctx, region)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read webhook signing key", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(region)
+	data.Region = types.StringValue(region)
+	applyWebhookSigningKey(key, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func applyWebhookSigningKey(key mailgunpkg.WebhookSigningKey, m *webhookSigningKeyModel) {
+	m.Key = types.StringValue(key.Key)
+	m.CreatedAt = stringOrNull(key.CreatedAt)
+}
@@ -0,0 +1,160 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ resource.Resource                = (*webhookSigningKeyResource)(nil)
+	_ resource.ResourceWithImportState = (*webhookSigningKeyResource)(nil)
+	_ resource.ResourceWithConfigure   = (*webhookSigningKeyResource)(nil)
+)
+
+// NewWebhookSigningKeyResource is the constructor registered with the
+// framework provider for mailgun_webhook_signing_key.
+func NewWebhookSigningKeyResource() resource.Resource {
+	return &webhookSigningKeyResource{}
+}
+
+// webhookSigningKeyResource adopts the webhook signing key of a region and
+// rotates it whenever keepers change. The key always exists in Mailgun, so
+// Create does not rotate and Delete only forgets it.
+type webhookSigningKeyResource struct {
+	cfg *mailgunpkg.Config
+}
+
+type webhookSigningKeyResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Region    types.String `tfsdk:"region"`
+	Keepers   types.Map    `tfsdk:"keepers"`
+	Key       types.String `tfsdk:"key"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (r *webhookSigningKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_signing_key"
+}
+
+func (r *webhookSigningKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("us"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *webhookSigningKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	r.cfg = cfg
+}
+
+// ImportState takes the region as the import id.
+func (r *webhookSigningKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), req.ID)...)
+}
+
+func (r *webhookSigningKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webhookSigningKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := plan.Region.ValueString()
+	key, err := r.cfg.GetWebhookSigningKey(ctx, region)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read webhook signing key", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(region)
+	applyWebhookSigningKeyResource(key, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *webhookSigningKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookSigningKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.cfg.GetWebhookSigningKey(ctx, state.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read webhook signing key", err.Error())
+		return
+	}
+	applyWebhookSigningKeyResource(key, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *webhookSigningKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webhookSigningKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// keepers is the only updatable attribute, so every Update is a rotation.
+	key, err := r.cfg.RotateWebhookSigningKey(ctx, plan.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to rotate webhook signing key", err.Error())
+		return
+	}
+	log.Printf("[INFO] Rotated webhook signing key of region: %s", plan.Region.ValueString())
+	applyWebhookSigningKeyResource(key, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the key from state; Mailgun accounts always have one.
+func (r *webhookSigningKeyResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func applyWebhookSigningKeyResource(key mailgunpkg.WebhookSigningKey, m *webhookSigningKeyResourceModel) {
+	m.Key = types.StringValue(key.Key)
+	m.CreatedAt = stringOrNull(key.CreatedAt)
+}
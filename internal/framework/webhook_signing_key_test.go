@@ -0,0 +1,54 @@
+package framework_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMailgunWebhookSigningKey_Rotate(t *testing.T) {
+	resourceName := "mailgun_webhook_signing_key.foobar"
+	var original string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunWebhookSigningKeyConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "us"),
+					resource.TestCheckResourceAttrSet(resourceName, "key"),
+					resource.TestCheckResourceAttrPair(resourceName, "key", "data.mailgun_webhook_signing_key.test", "key"),
+					resource.TestCheckResourceAttrWith(resourceName, "key", func(v string) error {
+						original = v
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccMailgunWebhookSigningKeyConfig("2"),
+				Check: resource.TestCheckResourceAttrWith(resourceName, "key", func(v string) error {
+					if v == original {
+						t.Errorf("changing keepers should rotate the signing key")
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func testAccMailgunWebhookSigningKeyConfig(generation string) string {
+	return `
+resource "mailgun_webhook_signing_key" "foobar" {
+  keepers = {
+    generation = "` + generation + `"
+  }
+}
+
+data "mailgun_webhook_signing_key" "test" {
+  region = mailgun_webhook_signing_key.foobar.region
+}
+`
+}
@@ -0,0 +1,92 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxWebhookURLs is the number of URLs Mailgun accepts per webhook kind.
+const maxWebhookURLs = 3
+
+var (
+	_ validator.String = webhookURLValidator{}
+	_ validator.Set    = uniqueWebhookURLsValidator{}
+)
+
+// webhookURLValidator requires an absolute http or https URL.
+type webhookURLValidator struct{}
+
+func webhookURL() validator.String { return webhookURLValidator{} }
+
+func (v webhookURLValidator) Description(_ context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v webhookURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v webhookURLValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := checkWebhookURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid webhook URL", err.Error())
+	}
+}
+
+// checkWebhookURL explains why raw is not a URL Mailgun can post to.
+func checkWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid URL: %s", raw, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%q must use the http or https scheme", raw)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%q must be an absolute URL with a host", raw)
+	}
+	return nil
+}
+
+// uniqueWebhookURLsValidator rejects sets holding the same URL twice with and
+// without a trailing slash. Terraform sees two distinct elements but Mailgun
+// treats them as one and fails the apply.
+type uniqueWebhookURLsValidator struct{}
+
+func uniqueWebhookURLs() validator.Set { return uniqueWebhookURLsValidator{} }
+
+func (v uniqueWebhookURLsValidator) Description(_ context.Context) string {
+	return "URLs must not differ only by a trailing slash"
+}
+
+func (v uniqueWebhookURLsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueWebhookURLsValidator) ValidateSet(_ context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	seen := map[string]string{}
+	for _, elem := range req.ConfigValue.Elements() {
+		s, ok := elem.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		raw := s.ValueString()
+		key := strings.TrimRight(raw, "/")
+		if prev, dup := seen[key]; dup {
+			resp.Diagnostics.AddAttributeError(req.Path, "Duplicate webhook URL",
+				fmt.Sprintf("%q and %q differ only by a trailing slash", prev, raw))
+			continue
+		}
+		seen[key] = raw
+	}
+}
@@ -0,0 +1,44 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckWebhookURL(t *testing.T) {
+	cases := map[string]bool{
+		"https://example.com/hook":  true,
+		"http://example.com:8080/x": true,
+		"example.com/hook":          false,
+		"/relative/path":            false,
+		"ftp://example.com":         false,
+		"https://":                  false,
+		"https://exa mple.com":      false,
+	}
+	for raw, ok := range cases {
+		if err := checkWebhookURL(raw); (err == nil) != ok {
+			t.Errorf("checkWebhookURL(%q) error = %v, want ok=%v", raw, err, ok)
+		}
+	}
+}
+
+func TestUniqueWebhookURLs(t *testing.T) {
+	ctx := context.Background()
+	validate := func(urls ...string) *validator.SetResponse {
+		value, _ := types.SetValueFrom(ctx, types.StringType, urls)
+		resp := &validator.SetResponse{}
+		uniqueWebhookURLs().ValidateSet(ctx, validator.SetRequest{Path: path.Root("urls"), ConfigValue: value}, resp)
+		return resp
+	}
+
+	if resp := validate("https://example.com/hook", "https://example.com/other"); resp.Diagnostics.HasError() {
+		t.Errorf("distinct URLs rejected: %v", resp.Diagnostics)
+	}
+	if resp := validate("https://example.com/hook", "https://example.com/hook/"); !resp.Diagnostics.HasError() {
+		t.Error("URLs differing only by a trailing slash should be rejected")
+	}
+}
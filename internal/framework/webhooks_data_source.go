@@ -0,0 +1,131 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mailgunpkg "github.com/wgebis/terraform-provider-mailgun/mailgun"
+)
+
+var (
+	_ datasource.DataSource              = (*webhooksDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*webhooksDataSource)(nil)
+)
+
+// NewWebhooksDataSource is the constructor registered with the framework
+// provider for data "mailgun_webhooks".
+func NewWebhooksDataSource() datasource.DataSource {
+	return &webhooksDataSource{}
+}
+
+type webhooksDataSource struct {
+	cfg *mailgunpkg.Config
+}
+
+type webhooksDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Region   types.String `tfsdk:"region"`
+	Domain   types.String `tfsdk:"domain"`
+	Webhooks types.Map    `tfsdk:"webhooks"`
+}
+
+func (d *webhooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks"
+}
+
+func (d *webhooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Attributes: map[string]dsschema.Attribute{
+			"id":     dsschema.StringAttribute{Computed: true},
+			"region": dsschema.StringAttribute{Optional: true, Computed: true},
+			"domain": dsschema.StringAttribute{Required: true},
+			"webhooks": dsschema.MapAttribute{
+				Computed:    true,
+				ElementType: types.SetType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (d *webhooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(*mailgunpkg.Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("expected *mailgun.Config, got %T", req.ProviderData))
+		return
+	}
+	d.cfg = cfg
+}
+
+func (d *webhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webhooksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = "us"
+	}
+	client, err := d.cfg.GetClient(region)
+	if err != nil {
+		resp.Diagnostics.AddError("Mailgun client error", err.Error())
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	hooks, err := client.ListWebhooks(ctx, domain)
+	if err != nil {
+		if mailgunpkg.IsNotFound(err) {
+			resp.Diagnostics.AddError("Domain not found",
+				fmt.Sprintf("Mailgun domain %q does not exist", domain))
+			return
+		}
+		resp.Diagnostics.AddError("Failed to list webhooks", err.Error())
+		return
+	}
+
+	webhooks, diags := webhookKindMap(ctx, hooks)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", region, domain))
+	data.Region = types.StringValue(region)
+	data.Webhooks = webhooks
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// webhookKindMap builds a map with an entry for every kind in
+// allowedWebhookKinds. Kinds without a webhook map to an empty set so callers
+// can index any kind without guarding against missing keys.
+func webhookKindMap(ctx context.Context, hooks map[string][]string) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elems := make(map[string]attr.Value, len(allowedWebhookKinds))
+	for _, kind := range allowedWebhookKinds {
+		urls := hooks[kind]
+		if urls == nil {
+			urls = []string{}
+		}
+		set, d := types.SetValueFrom(ctx, types.StringType, urls)
+		diags.Append(d...)
+		elems[kind] = set
+	}
+	if diags.HasError() {
+		return types.MapNull(types.SetType{ElemType: types.StringType}), diags
+	}
+	m, d := types.MapValue(types.SetType{ElemType: types.StringType}, elems)
+	diags.Append(d...)
+	return m, diags
+}
@@ -0,0 +1,40 @@
+package framework_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMailgunWebhooksDataSource_Basic(t *testing.T) {
+	uuid, _ := uuid.GenerateUUID()
+	domain := fmt.Sprintf("terraformwhds.%s.com", uuid)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6Providers(),
+		CheckDestroy:             testAccCheckMailgunWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailgunWebhooksDataSourceConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mailgun_webhooks.test", "webhooks.%", "11"),
+					resource.TestCheckResourceAttr("data.mailgun_webhooks.test", "webhooks.delivered.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.mailgun_webhooks.test", "webhooks.delivered.*", "https://hoge.com"),
+					resource.TestCheckResourceAttr("data.mailgun_webhooks.test", "webhooks.opened.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMailgunWebhooksDataSourceConfig(domain string) string {
+	return testAccCheckMailgunWebhookConfig(domain) + `
+
+data "mailgun_webhooks" "test" {
+  domain = mailgun_webhook.foobar.domain
+  region = "us"
+}`
+}
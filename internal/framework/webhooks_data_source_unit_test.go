@@ -0,0 +1,34 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWebhookKindMap_CoversEveryKind(t *testing.T) {
+	ctx := context.Background()
+	m, diags := webhookKindMap(ctx, map[string][]string{
+		"delivered": {"https://a.example.com", "https://b.example.com"},
+		"legacy":    {"https://ignored.example.com"},
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	elems := m.Elements()
+	if len(elems) != len(allowedWebhookKinds) {
+		t.Fatalf("expected %d kinds, got %d", len(allowedWebhookKinds), len(elems))
+	}
+	if _, ok := elems["legacy"]; ok {
+		t.Error("kinds outside allowedWebhookKinds must be dropped")
+	}
+	if got := len(elems["delivered"].(types.Set).Elements()); got != 2 {
+		t.Errorf("delivered should carry 2 urls, got %d", got)
+	}
+	opened := elems["opened"].(types.Set)
+	if opened.IsNull() || len(opened.Elements()) != 0 {
+		t.Errorf("unconfigured kind should be an empty set, got %s", opened)
+	}
+}
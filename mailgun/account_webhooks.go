@@ -0,0 +1,81 @@
+package mailgun
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/mailgun/mailgun-go/v5"
+)
+
+// AccountWebhook is an account-level webhook. Unlike domain webhooks it
+// fires for events of every domain in the account.
+type AccountWebhook struct {
+	ID          string   `json:"webhook_id"`
+	URL         string   `json:"url"`
+	EventTypes  []string `json:"event_types"`
+	Description string   `json:"description"`
+}
+
+func (w AccountWebhook) form() url.Values {
+	form := url.Values{}
+	form.Set("url", w.URL)
+	for _, kind := range w.EventTypes {
+		form.Add("event_types", kind)
+	}
+	form.Set("description", w.Description)
+	return form
+}
+
+// CreateAccountWebhook registers w and returns the id Mailgun assigned to it.
+func (c *Config) CreateAccountWebhook(ctx context.Context, region string, w AccountWebhook) (string, error) {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return "", err
+	}
+	return createAccountWebhook(ctx, client, w)
+}
+
+// GetAccountWebhook fetches the account webhook with the given id.
+func (c *Config) GetAccountWebhook(ctx context.Context, region, id string) (AccountWebhook, error) {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return AccountWebhook{}, err
+	}
+	return getAccountWebhook(ctx, client, id)
+}
+
+// UpdateAccountWebhook replaces the url, event types and description of the
+// account webhook w.ID.
+func (c *Config) UpdateAccountWebhook(ctx context.Context, region string, w AccountWebhook) error {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return err
+	}
+	return doREST(ctx, client, http.MethodPut, "/v1/webhooks/"+url.PathEscape(w.ID), w.form(), nil)
+}
+
+// DeleteAccountWebhook removes the account webhook with the given id.
+func (c *Config) DeleteAccountWebhook(ctx context.Context, region, id string) error {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return err
+	}
+	return doREST(ctx, client, http.MethodDelete, "/v1/webhooks/"+url.PathEscape(id), nil, nil)
+}
+
+func createAccountWebhook(ctx context.Context, client *mailgun.Client, w AccountWebhook) (string, error) {
+	var created struct {
+		ID string `json:"webhook_id"`
+	}
+	if err := doREST(ctx, client, http.MethodPost, "/v1/webhooks", w.form(), &created); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+func getAccountWebhook(ctx context.Context, client *mailgun.Client, id string) (AccountWebhook, error) {
+	var w AccountWebhook
+	err := doREST(ctx, client, http.MethodGet, "/v1/webhooks/"+url.PathEscape(id), nil, &w)
+	return w, err
+}
@@ -0,0 +1,45 @@
+package mailgun
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mailgun/mailgun-go/v5"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+)
+
+// apiKeyCacheKey identifies one server-side filtered ListAPIKeys listing.
+// Mailgun lets ListAPIKeys filter by kind and domain_name, so those are part
+// of the key; everything else is filtered by the caller.
+type apiKeyCacheKey struct {
+	region string
+	kind   string
+	domain string
+}
+
+// ListAPIKeys returns the API keys in region, optionally filtered server-side
+// by opts. Results are cached for the lifetime of the provider process per
+// region and filter combination; mailgun_api_key reads list without filters
+// so refreshing any number of keys costs one list call per region.
+func (c *Config) ListAPIKeys(ctx context.Context, region string, opts *mailgun.ListAPIKeysOptions) ([]mtypes.APIKey, error) {
+	key := apiKeyCacheKey{region: strings.ToLower(region)}
+	if opts != nil {
+		key.kind, key.domain = opts.Kind, opts.DomainName
+	}
+	return c.apiKeys.get(ctx, key, func(ctx context.Context) ([]mtypes.APIKey, error) {
+		client, err := c.GetClient(region)
+		if err != nil {
+			return nil, err
+		}
+		listCtx, cancel := context.WithTimeout(ctx, c.pageTimeout())
+		defer cancel()
+		return client.ListAPIKeys(listCtx, opts)
+	})
+}
+
+// InvalidateAPIKeys discards cached listings for region. Resources call it
+// after creating or deleting a key so later reads in the same run see it.
+func (c *Config) InvalidateAPIKeys(region string) {
+	region = strings.ToLower(region)
+	c.apiKeys.invalidate(func(k apiKeyCacheKey) bool { return k.region == region })
+}
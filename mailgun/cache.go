@@ -0,0 +1,59 @@
+package mailgun
+
+import (
+	"context"
+	"sync"
+)
+
+// runCache memoises list results for the lifetime of the provider process.
+// The cache lives on Config, which the provider hands to every resource and
+// data source, and Terraform starts a fresh provider process for each plan or
+// apply, so an entry is shared by all reads of one run and never outlives it.
+type runCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	entries map[K]*runCacheEntry[V]
+}
+
+type runCacheEntry[V any] struct {
+	once  sync.Once
+	value V
+	err   error
+}
+
+// get returns the value for key, calling load at most once per key even when
+// several reads race on a cold cache. Failed loads are not cached. load gets
+// ctx detached from its cancellation: every read waiting on the key shares
+// the result, so one cancelled read must not fail the others.
+func (c *runCache[K, V]) get(ctx context.Context, key K, load func(context.Context) (V, error)) (V, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[K]*runCacheEntry[V]{}
+	}
+	e, ok := c.entries[key]
+	if !ok {
+		e = &runCacheEntry[V]{}
+		c.entries[key] = e
+	}
+	c.mu.Unlock()
+
+	e.once.Do(func() { e.value, e.err = load(context.WithoutCancel(ctx)) })
+	if e.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == e {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+	}
+	return e.value, e.err
+}
+
+// invalidate drops every entry whose key matches.
+func (c *runCache[K, V]) invalidate(match func(K) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.entries {
+		if match(k) {
+			delete(c.entries, k)
+		}
+	}
+}
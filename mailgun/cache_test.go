@@ -0,0 +1,113 @@
+package mailgun
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+)
+
+func TestRunCache_LoadsOncePerKey(t *testing.T) {
+	var c runCache[apiKeyCacheKey, []mtypes.APIKey]
+	calls := 0
+	load := func(context.Context) ([]mtypes.APIKey, error) {
+		calls++
+		return []mtypes.APIKey{{ID: "k1"}}, nil
+	}
+
+	key := apiKeyCacheKey{region: "us"}
+	for i := 0; i < 3; i++ {
+		keys, err := c.get(context.Background(), key, load)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) != 1 || keys[0].ID != "k1" {
+			t.Fatalf("unexpected keys %#v", keys)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected one load, got %d", calls)
+	}
+
+	if _, err := c.get(context.Background(), apiKeyCacheKey{region: "us", kind: "domain"}, load); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("different filter should load separately, got %d loads", calls)
+	}
+}
+
+func TestRunCache_ConcurrentReadersShareLoad(t *testing.T) {
+	var c runCache[apiKeyCacheKey, []mtypes.APIKey]
+	var mu sync.Mutex
+	calls := 0
+	load := func(context.Context) ([]mtypes.APIKey, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		return nil, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = c.get(context.Background(), apiKeyCacheKey{region: "eu"}, load)
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("expected one load across concurrent readers, got %d", calls)
+	}
+}
+
+func TestRunCache_InvalidateAndErrors(t *testing.T) {
+	var c runCache[apiKeyCacheKey, []mtypes.APIKey]
+	calls := 0
+	load := func(context.Context) ([]mtypes.APIKey, error) {
+		calls++
+		return nil, nil
+	}
+
+	_, _ = c.get(context.Background(), apiKeyCacheKey{region: "us"}, load)
+	_, _ = c.get(context.Background(), apiKeyCacheKey{region: "eu"}, load)
+	c.invalidate(func(k apiKeyCacheKey) bool { return k.region == "us" })
+	_, _ = c.get(context.Background(), apiKeyCacheKey{region: "us"}, load)
+	_, _ = c.get(context.Background(), apiKeyCacheKey{region: "eu"}, load)
+	if calls != 3 {
+		t.Errorf("invalidate should only drop the given region, got %d loads", calls)
+	}
+
+	boom := errors.New("boom")
+	failing := func(context.Context) ([]mtypes.APIKey, error) {
+		calls++
+		return nil, boom
+	}
+	key := apiKeyCacheKey{region: "us", domain: "example.com"}
+	if _, err := c.get(context.Background(), key, failing); !errors.Is(err, boom) {
+		t.Fatalf("expected load error, got %v", err)
+	}
+	before := calls
+	if _, err := c.get(context.Background(), key, load); err != nil {
+		t.Fatalf("failed load must not be cached, got %v", err)
+	}
+	if calls != before+1 {
+		t.Errorf("expected a reload after a failed load")
+	}
+}
+
+func TestRunCache_LoadIgnoresCallerCancellation(t *testing.T) {
+	var c runCache[apiKeyCacheKey, []mtypes.APIKey]
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	load := func(ctx context.Context) ([]mtypes.APIKey, error) {
+		return nil, ctx.Err()
+	}
+	if _, err := c.get(ctx, apiKeyCacheKey{region: "us"}, load); err != nil {
+		t.Errorf("a cancelled caller must not fail the shared load: %s", err)
+	}
+}
@@ -2,13 +2,34 @@ package mailgun
 
 import (
 	"strings"
+	"time"
 
 	"github.com/mailgun/mailgun-go/v5"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
 )
 
-// Config struct holds API key
+// Config struct holds API key and the per-run caches shared by every
+// resource and data source.
 type Config struct {
 	APIKey string
+
+	// PageTimeout bounds each page request made while paging through
+	// list endpoints. Zero means DefaultPageTimeout.
+	PageTimeout time.Duration
+
+	apiKeys     runCache[apiKeyCacheKey, []mtypes.APIKey]
+	credentials runCache[credentialIndexKey, map[string]Credential]
+}
+
+// DefaultPageTimeout is the per-page timeout used when Config.PageTimeout is
+// not set.
+const DefaultPageTimeout = 30 * time.Second
+
+func (c *Config) pageTimeout() time.Duration {
+	if c.PageTimeout > 0 {
+		return c.PageTimeout
+	}
+	return DefaultPageTimeout
 }
 
 // GetClient returns a fresh Mailgun client for the given region. A new client
@@ -0,0 +1,88 @@
+package mailgun
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mailgun/mailgun-go/v5"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+)
+
+// credentialIndexKey identifies the credential index of one domain.
+type credentialIndexKey struct {
+	region string
+	domain string
+}
+
+// Credential is an SMTP credential as listed by Mailgun. mailgun-go's
+// mtypes.Credential drops the mailbox metadata, so the listing is fetched
+// with doREST. SizeBytes is nil when Mailgun does not report a size.
+type Credential struct {
+	Login     string             `json:"login"`
+	Mailbox   string             `json:"mailbox"`
+	CreatedAt mtypes.RFC2822Time `json:"created_at"`
+	SizeBytes *int64             `json:"size_bytes"`
+}
+
+// credentialsPageLimit is the page size used when listing credentials.
+const credentialsPageLimit = 100
+
+// Credentials returns every SMTP credential on domain keyed by login. The
+// index is built by paging through the credential listing once per domain
+// and run and is then shared by all credential reads, so refreshing hundreds
+// of mailgun_domain_credential resources on one domain no longer pages
+// through the full listing for each of them.
+func (c *Config) Credentials(ctx context.Context, region, domain string) (map[string]Credential, error) {
+	key := credentialIndexKey{region: strings.ToLower(region), domain: domain}
+	return c.credentials.get(ctx, key, func(ctx context.Context) (map[string]Credential, error) {
+		client, err := c.GetClient(region)
+		if err != nil {
+			return nil, err
+		}
+		creds, err := listCredentials(ctx, client, domain, c.pageTimeout())
+		if err != nil {
+			return nil, err
+		}
+		index := make(map[string]Credential, len(creds))
+		for _, cred := range creds {
+			index[cred.Login] = cred
+		}
+		return index, nil
+	})
+}
+
+// listCredentials pages through the credentials of domain. Each page request
+// is bounded by pageTimeout.
+func listCredentials(ctx context.Context, client *mailgun.Client, domain string, pageTimeout time.Duration) ([]Credential, error) {
+	endpoint := "/v3/domains/" + url.PathEscape(domain) + "/credentials"
+	var creds []Credential
+	for skip := 0; ; skip += credentialsPageLimit {
+		q := url.Values{}
+		q.Set("skip", strconv.Itoa(skip))
+		q.Set("limit", strconv.Itoa(credentialsPageLimit))
+		var page struct {
+			Items []Credential `json:"items"`
+		}
+		pageCtx, cancel := context.WithTimeout(ctx, pageTimeout)
+		err := doREST(pageCtx, client, http.MethodGet, endpoint, q, &page)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		creds = append(creds, page.Items...)
+		if len(page.Items) < credentialsPageLimit {
+			return creds, nil
+		}
+	}
+}
+
+// InvalidateCredentials discards the cached index of domain. Resources call
+// it after creating or deleting a credential.
+func (c *Config) InvalidateCredentials(region, domain string) {
+	key := credentialIndexKey{region: strings.ToLower(region), domain: domain}
+	c.credentials.invalidate(func(k credentialIndexKey) bool { return k == key })
+}
@@ -0,0 +1,48 @@
+package mailgun
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListCredentials_Pages(t *testing.T) {
+	total := credentialsPageLimit + 1
+	client := testRESTClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v3/domains/mg.example.com/credentials" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		var items []string
+		for i := skip; i < total && i < skip+credentialsPageLimit; i++ {
+			size := "null"
+			if i == 0 {
+				size = "1048576"
+			}
+			items = append(items, fmt.Sprintf(`{"login":"u%d@mg.example.com","mailbox":"u%d@mg.example.com",`+
+				`"created_at":"Tue, 04 Mar 2025 05:06:07 GMT","size_bytes":%s}`, i, i, size))
+		}
+		_, _ = fmt.Fprintf(w, `{"total_count":%d,"items":[%s]}`, total, strings.Join(items, ","))
+	})
+
+	creds, err := listCredentials(context.Background(), client, "mg.example.com", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(creds) != total {
+		t.Fatalf("got %d credentials, want %d", len(creds), total)
+	}
+	if c := creds[0]; c.Login != "u0@mg.example.com" || c.SizeBytes == nil || *c.SizeBytes != 1048576 {
+		t.Errorf("unexpected first credential %+v", c)
+	}
+	if creds[1].SizeBytes != nil {
+		t.Errorf("missing size_bytes should stay nil, got %d", *creds[1].SizeBytes)
+	}
+	if time.Time(creds[0].CreatedAt).IsZero() {
+		t.Error("created_at not decoded")
+	}
+}
@@ -0,0 +1,144 @@
+package mailgun
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/mailgun/mailgun-go/v5"
+)
+
+const dkimKeysEndpoint = "/v1/dkim/keys"
+
+// DkimKey is a DKIM signing key of a domain. A domain can hold several keys;
+// only active ones are used for signing.
+type DkimKey struct {
+	SigningDomain string        `json:"signing_domain"`
+	Selector      string        `json:"selector"`
+	DNSRecord     DkimDNSRecord `json:"dns_record"`
+}
+
+// DkimDNSRecord is the TXT record that publishes the public half of a key.
+type DkimDNSRecord struct {
+	Active     bool   `json:"is_active"`
+	Name       string `json:"name"`
+	RecordType string `json:"record_type"`
+	Valid      string `json:"valid"`
+	Value      string `json:"value"`
+}
+
+// CreateDkimKeyOptions describes a new key. Bits is ignored when PEM carries
+// a private key of your own.
+type CreateDkimKeyOptions struct {
+	SigningDomain string
+	Selector      string
+	Bits          int
+	PEM           string
+}
+
+// CreateDkimKey generates or uploads a DKIM key.
+func (c *Config) CreateDkimKey(ctx context.Context, region string, opts CreateDkimKeyOptions) (DkimKey, error) {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return DkimKey{}, err
+	}
+	return createDkimKey(ctx, client, opts)
+}
+
+// GetDkimKey fetches the key of domain with the given selector.
+func (c *Config) GetDkimKey(ctx context.Context, region, domain, selector string) (DkimKey, error) {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return DkimKey{}, err
+	}
+	return getDkimKey(ctx, client, domain, selector)
+}
+
+// ListDkimKeys returns every key of domain.
+func (c *Config) ListDkimKeys(ctx context.Context, region, domain string) ([]DkimKey, error) {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return nil, err
+	}
+	q := url.Values{}
+	q.Set("signing_domain", domain)
+	return listDkimKeys(ctx, client, q)
+}
+
+// SetDkimKeyActive activates or deactivates the key of domain with the given
+// selector.
+func (c *Config) SetDkimKeyActive(ctx context.Context, region, domain, selector string, active bool) error {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return err
+	}
+	return setDkimKeyActive(ctx, client, domain, selector, active)
+}
+
+// DeleteDkimKey removes the key of domain with the given selector.
+func (c *Config) DeleteDkimKey(ctx context.Context, region, domain, selector string) error {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return err
+	}
+	return doREST(ctx, client, http.MethodDelete, dkimKeysEndpoint, dkimKeyQuery(domain, selector), nil)
+}
+
+func dkimKeyQuery(domain, selector string) url.Values {
+	q := url.Values{}
+	q.Set("signing_domain", domain)
+	q.Set("selector", selector)
+	return q
+}
+
+func createDkimKey(ctx context.Context, client *mailgun.Client, opts CreateDkimKeyOptions) (DkimKey, error) {
+	form := dkimKeyQuery(opts.SigningDomain, opts.Selector)
+	if opts.PEM != "" {
+		form.Set("pem", opts.PEM)
+	} else if opts.Bits != 0 {
+		form.Set("bits", strconv.Itoa(opts.Bits))
+	}
+	var key DkimKey
+	err := doREST(ctx, client, http.MethodPost, dkimKeysEndpoint, form, &key)
+	return key, err
+}
+
+func listDkimKeys(ctx context.Context, client *mailgun.Client, q url.Values) ([]DkimKey, error) {
+	var page struct {
+		Items []DkimKey `json:"items"`
+	}
+	err := doREST(ctx, client, http.MethodGet, dkimKeysEndpoint, q, &page)
+	return page.Items, err
+}
+
+// getDkimKey lists the keys matching domain and selector. Mailgun answers an
+// empty list rather than 404 for unknown keys, so that case is turned into a
+// not-found error here.
+func getDkimKey(ctx context.Context, client *mailgun.Client, domain, selector string) (DkimKey, error) {
+	keys, err := listDkimKeys(ctx, client, dkimKeyQuery(domain, selector))
+	if err != nil {
+		return DkimKey{}, err
+	}
+	for _, key := range keys {
+		if key.SigningDomain == domain && key.Selector == selector {
+			return key, nil
+		}
+	}
+	return DkimKey{}, &mailgun.UnexpectedResponseError{
+		Expected: []int{http.StatusOK},
+		Actual:   http.StatusNotFound,
+		Method:   http.MethodGet,
+		URL:      dkimKeysEndpoint,
+		Data:     []byte("DKIM key " + selector + " of " + domain + " not found"),
+	}
+}
+
+func setDkimKeyActive(ctx context.Context, client *mailgun.Client, domain, selector string, active bool) error {
+	action := "deactivate"
+	if active {
+		action = "activate"
+	}
+	endpoint := "/v4/domains/" + url.PathEscape(domain) + "/keys/" + url.PathEscape(selector) + "/" + action
+	return doREST(ctx, client, http.MethodPut, endpoint, nil, nil)
+}
@@ -0,0 +1,80 @@
+package mailgun
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/mailgun/mailgun-go/v5"
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+)
+
+// ListDomains pages through every domain in region. Each page request is
+// bounded by the configured page timeout.
+func (c *Config) ListDomains(ctx context.Context, region string) ([]mtypes.Domain, error) {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return nil, err
+	}
+	var domains []mtypes.Domain
+	it := client.ListDomains(nil)
+	var page []mtypes.Domain
+	for {
+		pageCtx, cancel := context.WithTimeout(ctx, c.pageTimeout())
+		more := it.Next(pageCtx, &page)
+		cancel()
+		if !more {
+			break
+		}
+		domains = append(domains, page...)
+	}
+	return domains, it.Err()
+}
+
+// DomainSettings holds the domain settings that mailgun-go's UpdateDomain
+// does not cover. Zero values are left unchanged.
+type DomainSettings struct {
+	SpamAction string
+	Wildcard   *bool
+}
+
+func (s DomainSettings) form() url.Values {
+	form := url.Values{}
+	if s.SpamAction != "" {
+		form.Set("spam_action", s.SpamAction)
+	}
+	if s.Wildcard != nil {
+		form.Set("wildcard", strconv.FormatBool(*s.Wildcard))
+	}
+	return form
+}
+
+// UpdateDomainSettings changes the settings in s of the domain name.
+func (c *Config) UpdateDomainSettings(ctx context.Context, region, name string, s DomainSettings) error {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return err
+	}
+	return updateDomainSettings(ctx, client, name, s)
+}
+
+func updateDomainSettings(ctx context.Context, client *mailgun.Client, name string, s DomainSettings) error {
+	return doREST(ctx, client, http.MethodPut, "/v4/domains/"+url.PathEscape(name), s.form(), nil)
+}
+
+// UpdateDomainDkimAuthority makes the domain name its own DKIM authority
+// when self is true, or shares the authority of its root domain otherwise.
+func (c *Config) UpdateDomainDkimAuthority(ctx context.Context, region, name string, self bool) error {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return err
+	}
+	return updateDomainDkimAuthority(ctx, client, name, self)
+}
+
+func updateDomainDkimAuthority(ctx context.Context, client *mailgun.Client, name string, self bool) error {
+	form := url.Values{}
+	form.Set("self", strconv.FormatBool(self))
+	return doREST(ctx, client, http.MethodPut, "/v3/domains/"+url.PathEscape(name)+"/dkim_authority", form, nil)
+}
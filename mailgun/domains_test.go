@@ -0,0 +1,47 @@
+package mailgun
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestUpdateDomainSettings(t *testing.T) {
+	client := testRESTClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/v4/domains/mg.example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if got := r.PostForm.Encode(); got != "spam_action=tag&wildcard=false" {
+			t.Errorf("form = %q", got)
+		}
+		_, _ = w.Write([]byte(`{"message":"Domain has been updated"}`))
+	})
+
+	wildcard := false
+	err := updateDomainSettings(context.Background(), client, "mg.example.com", DomainSettings{SpamAction: "tag", Wildcard: &wildcard})
+	if err != nil {
+		t.Fatalf("update: %s", err)
+	}
+}
+
+func TestUpdateDomainDkimAuthority(t *testing.T) {
+	client := testRESTClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/v3/domains/mg.example.com/dkim_authority" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if got := r.PostForm.Encode(); got != "self=true" {
+			t.Errorf("form = %q", got)
+		}
+		_, _ = w.Write([]byte(`{"changed":true,"message":"Domain DKIM authority has been changed"}`))
+	})
+
+	if err := updateDomainDkimAuthority(context.Background(), client, "mg.example.com", true); err != nil {
+		t.Fatalf("update: %s", err)
+	}
+}
@@ -0,0 +1,66 @@
+package mailgun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mailgun/mailgun-go/v5"
+)
+
+// doREST issues an authenticated request against a Mailgun endpoint that
+// mailgun-go does not wrap. form is sent url-encoded in the body for
+// POST/PUT and as the query string otherwise; out, when non-nil, receives
+// the decoded JSON response. Non-2xx responses are returned as
+// *mailgun.UnexpectedResponseError so IsNotFound works on them as well.
+func doREST(ctx context.Context, client *mailgun.Client, method, endpoint string, form url.Values, out any) error {
+	u := strings.TrimSuffix(client.APIBase(), "/") + endpoint
+
+	var body io.Reader
+	if method == http.MethodPost || method == http.MethodPut {
+		body = strings.NewReader(form.Encode())
+	} else if len(form) > 0 {
+		u += "?" + form.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth("api", client.APIKey())
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := client.HTTPClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &mailgun.UnexpectedResponseError{
+			Expected: []int{http.StatusOK},
+			Actual:   resp.StatusCode,
+			Method:   method,
+			URL:      u,
+			Data:     data,
+		}
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decoding %s %s response: %w", method, endpoint, err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package mailgun
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mailgun/mailgun-go/v5"
+)
+
+func testRESTClient(t *testing.T, handler http.HandlerFunc) *mailgun.Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	client := mailgun.NewMailgun("key-test")
+	if err := client.SetAPIBase(srv.URL); err != nil {
+		t.Fatalf("set api base: %s", err)
+	}
+	return client
+}
+
+func TestCreateAccountWebhook(t *testing.T) {
+	client := testRESTClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/webhooks" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "api" || pass != "key-test" {
+			t.Errorf("missing basic auth, got %q/%q", user, pass)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if got := r.PostForm["event_types"]; len(got) != 2 || got[0] != "delivered" || got[1] != "opened" {
+			t.Errorf("event_types = %v", got)
+		}
+		if got := r.PostForm.Get("url"); got != "https://example.com/hook" {
+			t.Errorf("url = %q", got)
+		}
+		_, _ = w.Write([]byte(`{"webhook_id":"wh-1"}`))
+	})
+
+	id, err := createAccountWebhook(context.Background(), client, AccountWebhook{
+		URL:        "https://example.com/hook",
+		EventTypes: []string{"delivered", "opened"},
+	})
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	if id != "wh-1" {
+		t.Errorf("id = %q, want wh-1", id)
+	}
+}
+
+func TestGetAccountWebhook_NotFound(t *testing.T) {
+	client := testRESTClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/webhooks/missing" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+	})
+
+	_, err := getAccountWebhook(context.Background(), client, "missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not-found error, got %v", err)
+	}
+}
+
+func TestGetAccountWebhook_Decodes(t *testing.T) {
+	client := testRESTClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"webhook_id":"wh-1","url":"https://example.com","event_types":["clicked"],"description":"all"}`))
+	})
+
+	got, err := getAccountWebhook(context.Background(), client, "wh-1")
+	if err != nil {
+		t.Fatalf("get: %s", err)
+	}
+	if got.ID != "wh-1" || got.URL != "https://example.com" || got.Description != "all" ||
+		len(got.EventTypes) != 1 || got.EventTypes[0] != "clicked" {
+		t.Errorf("unexpected webhook %+v", got)
+	}
+}
+
+func TestDoREST_PostWithoutForm(t *testing.T) {
+	client := testRESTClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != signingKeyEndpoint {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"message":"ok","http_signing_key":"new-key"}`))
+	})
+
+	var key WebhookSigningKey
+	if err := doREST(context.Background(), client, http.MethodPost, signingKeyEndpoint, nil, &key); err != nil {
+		t.Fatalf("rotate: %s", err)
+	}
+	if key.Key != "new-key" {
+		t.Errorf("key = %q, want new-key", key.Key)
+	}
+}
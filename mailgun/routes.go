@@ -0,0 +1,29 @@
+package mailgun
+
+import (
+	"context"
+
+	"github.com/mailgun/mailgun-go/v5/mtypes"
+)
+
+// ListRoutes pages through every route in region. Each page request is
+// bounded by the configured page timeout.
+func (c *Config) ListRoutes(ctx context.Context, region string) ([]mtypes.Route, error) {
+	client, err := c.GetClient(region)
+	if err != nil {
+		return nil, err
+	}
+	var routes []mtypes.Route
+	it := client.ListRoutes(nil)
+	var page []mtypes.Route
+	for {
+		pageCtx, cancel := context.WithTimeout(ctx, c.pageTimeout())
+		more := it.Next(pageCtx, &page)
+		cancel()
+		if !more {
+			break
+		}
+		routes = append(routes, page...)
+	}
+	return routes, it.Err()
+}
@@ -0,0 +1,46 @@
+package mailgun
+
+import (
+	"context"
+	"net/http"
+)
+
+// WebhookSigningKey is the HTTP webhook signing key of the account. Webhook
+// receivers use it to verify the HMAC signature of each request.
+type WebhookSigningKey struct {
+	Key       string `json:"http_signing_key"`
+	CreatedAt string `json:"created_at"`
+}
+
+const signingKeyEndpoint = "/v5/accounts/http_signing_key"
+
+// GetWebhookSigningKey returns the current webhook signing key of region.
+func (c *Config) GetWebhookSigningKey(ctx context.Context, region string) (WebhookSigningKey, error) {
+	var key WebhookSigningKey
+	client, err := c.GetClient(region)
+	if err != nil {
+		return key, err
+	}
+	err = doREST(ctx, client, http.MethodGet, signingKeyEndpoint, nil, &key)
+	return key, err
+}
+
+// RotateWebhookSigningKey replaces the webhook signing key of region with a
+// newly generated one. Receivers still verifying with the old key start
+// rejecting requests immediately.
+func (c *Config) RotateWebhookSigningKey(ctx context.Context, region string) (WebhookSigningKey, error) {
+	var key WebhookSigningKey
+	client, err := c.GetClient(region)
+	if err != nil {
+		return key, err
+	}
+	if err := doREST(ctx, client, http.MethodPost, signingKeyEndpoint, nil, &key); err != nil {
+		return key, err
+	}
+	// The rotate response carries the new key but not always its creation
+	// time, so read it back.
+	if key.CreatedAt == "" {
+		return c.GetWebhookSigningKey(ctx, region)
+	}
+	return key, nil
+}